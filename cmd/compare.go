@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+
+	"github.com/jlgale/wordle"
+)
+
+// lostScore is the number of guesses a lost game counts as when
+// comparing strategies.
+const lostScore = wordle.GuessLimit + 1
+
+// strategySummary is how one strategy did over a set of games.
+type strategySummary struct {
+	name    string
+	games   int
+	wins    int
+	guesses int
+	hist    histogram
+}
+
+func summarize(name string, results []gameResult) strategySummary {
+	s := strategySummary{name: name, games: len(results)}
+	for _, r := range results {
+		if r.game.Won() {
+			s.wins++
+		}
+		s.guesses += len(r.game.Guesses)
+		s.hist.add(r.game)
+	}
+	return s
+}
+
+func (s strategySummary) winRate() float64 {
+	return float64(s.wins) / float64(s.games)
+}
+
+func (s strategySummary) meanGuesses() float64 {
+	return float64(s.guesses) / float64(s.games)
+}
+
+// score is the number of guesses a game took, or lostScore if lost.
+func score(game wordle.Game) float64 {
+	if !game.Won() {
+		return lostScore
+	}
+	return float64(len(game.Guesses))
+}
+
+// pairedTTest tests whether the mean of the paired differences diffs
+// is zero. It returns the mean, its standard error, the t statistic
+// and the two-sided p-value.
+func pairedTTest(diffs []float64) (mean, stderr, t, p float64) {
+	n := float64(len(diffs))
+	if n < 2 {
+		return 0, 0, 0, 1
+	}
+	for _, d := range diffs {
+		mean += d
+	}
+	mean /= n
+	var ss float64
+	for _, d := range diffs {
+		ss += (d - mean) * (d - mean)
+	}
+	stderr = math.Sqrt(ss / (n - 1) / n)
+	if stderr == 0 {
+		if mean == 0 {
+			return mean, 0, 0, 1
+		}
+		return mean, 0, math.Copysign(math.Inf(1), mean), 0
+	}
+	t = mean / stderr
+	df := n - 1
+	p = incompleteBeta(df/(df+t*t), df/2, 0.5)
+	return
+}
+
+// incompleteBeta is the regularized incomplete beta function
+// I_x(a, b), evaluated by continued fraction.
+func incompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1-x))
+	// The continued fraction converges quickly only below this point,
+	// otherwise use the symmetry I_x(a, b) = 1 - I_1-x(b, a).
+	if x < (a+1)/(a+b+2) {
+		return front * betaFraction(x, a, b) / a
+	}
+	return 1 - front*betaFraction(1-x, b, a)/b
+}
+
+// betaFraction evaluates the continued fraction for incompleteBeta
+// with the modified Lentz method.
+func betaFraction(x, a, b float64) float64 {
+	const tiny = 1e-300
+	const eps = 1e-14
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	f := d
+	for m := 1.0; m <= 300; m++ {
+		// Even step
+		num := m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		f *= c * d
+		// Odd step
+		num = -(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := c * d
+		f *= delta
+		if math.Abs(delta-1) < eps {
+			break
+		}
+	}
+	return f
+}
+
+// printComparison writes a summary of each strategy, then compares
+// each to the first with a paired t-test on the guesses per game.
+// Every results slice must hold the same games in the same order.
+func printComparison(out io.Writer, names []string, results [][]gameResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(w, "Strategy\tGames\tWon\tWin %\tMean")
+	for n := 1; n <= wordle.GuessLimit; n++ {
+		fmt.Fprintf(w, "\t%d", n)
+	}
+	fmt.Fprint(w, "\tX\t\n")
+	var summaries = make([]strategySummary, len(names))
+	for idx, name := range names {
+		s := summarize(name, results[idx])
+		summaries[idx] = s
+		fmt.Fprintf(w, "%s\t%d\t%d\t%0.1f\t%0.3f", s.name, s.games, s.wins,
+			s.winRate()*100, s.meanGuesses())
+		for _, count := range s.hist {
+			fmt.Fprintf(w, "\t%d", count)
+		}
+		fmt.Fprint(w, "\t\n")
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\nPaired differences from %s, counting a lost game as %d guesses:\n",
+		names[0], lostScore)
+	base := results[0]
+	for idx := 1; idx < len(names); idx++ {
+		diffs := make([]float64, len(base))
+		for i, r := range results[idx] {
+			diffs[i] = score(r.game) - score(base[i].game)
+		}
+		mean, stderr, t, p := pairedTTest(diffs)
+		fmt.Fprintf(out, "  %s: %+0.3f guesses per game (s.e. %0.3f), %+d wins, t = %0.2f, p = %0.3g\n",
+			names[idx], mean, stderr, summaries[idx].wins-summaries[0].wins, t, p)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncompleteBeta(t *testing.T) {
+	assert.Equal(t, 0.0, incompleteBeta(0, 2, 3))
+	assert.Equal(t, 1.0, incompleteBeta(1, 2, 3))
+	// I_x(1, 1) = x
+	assert.InDelta(t, 0.3, incompleteBeta(0.3, 1, 1), 1e-12)
+	// I_x(a, 1) = x^a
+	assert.InDelta(t, math.Pow(0.6, 2.5), incompleteBeta(0.6, 2.5, 1), 1e-12)
+	// Symmetry
+	assert.InDelta(t, 1-incompleteBeta(0.8, 4, 2), incompleteBeta(0.2, 2, 4), 1e-12)
+}
+
+func TestPairedTTest(t *testing.T) {
+	mean, stderr, tstat, p := pairedTTest([]float64{0, 0, 0, 0})
+	assert.Equal(t, 0.0, mean)
+	assert.Equal(t, 0.0, stderr)
+	assert.Equal(t, 0.0, tstat)
+	assert.Equal(t, 1.0, p)
+
+	// t = 2 with 10 degrees of freedom, two-sided p = 0.0734
+	diffs := []float64{1, -1, 1, -1, 1, -1, 1, -1, 1, -1, 0}
+	mean, stderr, _, _ = pairedTTest(diffs)
+	shift := 2 * stderr
+	for idx := range diffs {
+		diffs[idx] += shift - mean
+	}
+	mean, _, tstat, p = pairedTTest(diffs)
+	assert.InDelta(t, shift, mean, 1e-12)
+	assert.InDelta(t, 2.0, tstat, 1e-9)
+	assert.InDelta(t, 0.0734, p, 1e-4)
+
+	// Large samples approach the normal distribution.
+	diffs = make([]float64, 10000)
+	for idx := range diffs {
+		diffs[idx] = float64(idx%2*2 - 1)
+	}
+	_, stderr, _, _ = pairedTTest(diffs)
+	for idx := range diffs {
+		diffs[idx] += 1.96 * stderr
+	}
+	_, _, _, p = pairedTTest(diffs)
+	assert.InDelta(t, 0.05, p, 1e-3)
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jlgale/wordle"
+)
+
+// strategyConfig describes a registered strategy or scoring, and its
+// parameters, as loaded from a --config file. For example:
+//
+//	{
+//	  "name": "fixed",
+//	  "open": ["crane"],
+//	  "strategy": {
+//	    "name": "hail-mary",
+//	    "strategy": {
+//	      "name": "filtering",
+//	      "fallback": {"name": "top", "scoring": "freq"}
+//	    },
+//	    "final": {"name": "weighted", "scoring": "freq", "exp": 2}
+//	  }
+//	}
+//
+// A strategy or scoring parameter is either an object like this, or
+// just a name. Omitted parameters take their registered defaults.
+type strategyConfig struct {
+	Name string
+	// Parameters, as decoded from JSON, or *strategyConfig.
+	Args map[string]interface{}
+}
+
+func (c *strategyConfig) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Keep numbers exact until we know if they're int or float.
+	dec.UseNumber()
+	var args map[string]interface{}
+	if err := dec.Decode(&args); err != nil {
+		return err
+	}
+	name, ok := args["name"].(string)
+	if !ok {
+		return fmt.Errorf("missing name")
+	}
+	delete(args, "name")
+	*c = strategyConfig{name, args}
+	return nil
+}
+
+// readConfig loads a strategyConfig from a JSON file.
+func readConfig(path string) (*strategyConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c strategyConfig
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// builder builds the strategies described by strategyConfigs from the
+// wordle registry.
+type builder struct {
+	env *wordle.Env
+}
+
+// strategy builds the strategy described by c, using rng for any
+// random choices.
+func (b *builder) strategy(c *strategyConfig, rng *rand.Rand) (wordle.Strategy, error) {
+	def, ok := wordle.LookupStrategy(c.Name)
+	if !ok {
+		return nil, fmt.Errorf("Unrecognized strategy: %s", c.Name)
+	}
+	args, err := b.args(c, def.Params, rng)
+	if err != nil {
+		return nil, err
+	}
+	return b.env.NewStrategy(rng, c.Name, args)
+}
+
+// scoring builds the scoring described by c.
+func (b *builder) scoring(c *strategyConfig) (wordle.Scoring, error) {
+	def, ok := wordle.LookupScoring(c.Name)
+	if !ok {
+		return nil, fmt.Errorf("Unrecognized scoring: %s", c.Name)
+	}
+	args, err := b.args(c, def.Params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.env.NewScoring(c.Name, args)
+}
+
+// args converts the parameters of c to the types of params. Values
+// that don't convert, and unknown parameters, are passed on as is,
+// for the registry to report.
+func (b *builder) args(c *strategyConfig, params []wordle.Param, rng *rand.Rand) (wordle.Args, error) {
+	var args = make(wordle.Args, len(c.Args))
+	for name, v := range c.Args {
+		args[name] = v
+	}
+	for _, p := range params {
+		v, ok := c.Args[p.Name]
+		if !ok {
+			continue
+		}
+		switch p.Kind {
+		case wordle.IntParam:
+			if n, ok := v.(json.Number); ok {
+				if i, err := n.Int64(); err == nil {
+					args[p.Name] = int(i)
+				}
+			}
+		case wordle.FloatParam:
+			if n, ok := v.(json.Number); ok {
+				if f, err := n.Float64(); err == nil {
+					args[p.Name] = f
+				}
+			}
+		case wordle.WordsParam:
+			var words []wordle.Word
+			var strs, ok = v.([]string)
+			if list, isList := v.([]interface{}); isList {
+				ok = true
+				for _, e := range list {
+					s, isString := e.(string)
+					ok = ok && isString
+					strs = append(strs, s)
+				}
+			}
+			if !ok {
+				continue
+			}
+			for _, s := range strs {
+				w, err := wordle.ParseWord(s)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s: %s: %w", c.Name, p.Name, s, err)
+				}
+				words = append(words, w)
+			}
+			args[p.Name] = words
+		case wordle.StrategyParam, wordle.ScoringParam:
+			sub, ok := subConfig(v)
+			if !ok {
+				continue
+			}
+			var err error
+			if p.Kind == wordle.StrategyParam {
+				args[p.Name], err = b.strategy(sub, rng)
+			} else {
+				args[p.Name], err = b.scoring(sub)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return args, nil
+}
+
+// subConfig interprets a strategy or scoring parameter: a name, a
+// JSON object, or a *strategyConfig.
+func subConfig(v interface{}) (*strategyConfig, bool) {
+	switch v := v.(type) {
+	case string:
+		return &strategyConfig{Name: v}, true
+	case *strategyConfig:
+		return v, true
+	case map[string]interface{}:
+		name, ok := v["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		var args = make(map[string]interface{}, len(v))
+		for k, a := range v {
+			if k != "name" {
+				args[k] = a
+			}
+		}
+		return &strategyConfig{name, args}, true
+	}
+	return nil, false
+}
+
+// newStrategy returns a function building the strategy described by
+// c for each game.
+func (b *builder) newStrategy(c *strategyConfig) func(rng *rand.Rand) (wordle.Strategy, error) {
+	return func(rng *rand.Rand) (wordle.Strategy, error) {
+		return b.strategy(c, rng)
+	}
+}
+
+// strategyFlags are the command line flags that describe a strategy,
+// as an alternative to a --config file.
+type strategyFlags struct {
+	strategy, fallback, hailmary, score string
+	exp                                 float64
+	threshold                           int
+	objective                           string
+	candidates                          int
+	open                                []string
+}
+
+// config returns the strategyConfig equivalent to the flags, but
+// playing the named strategy rather than the --strategy flag. name is
+// either a registered strategy, or a scoring to pick words by as
+// --score says.
+func (f strategyFlags) config(name string) (*strategyConfig, error) {
+	var pick = func(name string) (*strategyConfig, error) {
+		if _, ok := wordle.LookupScoring(name); !ok {
+			return &strategyConfig{Name: name}, nil
+		}
+		switch strings.ToLower(f.score) {
+		case "random":
+			return &strategyConfig{"weighted", map[string]interface{}{"scoring": name, "exp": f.exp}}, nil
+		case "top":
+			return &strategyConfig{"top", map[string]interface{}{"scoring": name}}, nil
+		}
+		return nil, fmt.Errorf("Unrecognized scoring function: %s", f.score)
+	}
+	c, err := pick(name)
+	if err != nil {
+		return nil, err
+	}
+	if def, ok := wordle.LookupStrategy(name); ok {
+		// Apply the flags to whichever parameters the strategy has.
+		c.Args = make(map[string]interface{})
+		for _, p := range def.Params {
+			switch p.Name {
+			case "fallback":
+				if c.Args[p.Name], err = pick(f.fallback); err != nil {
+					return nil, err
+				}
+			case "threshold":
+				c.Args[p.Name] = f.threshold
+			case "exp":
+				c.Args[p.Name] = f.exp
+			case "objective":
+				c.Args[p.Name] = f.objective
+			case "candidates":
+				c.Args[p.Name] = f.candidates
+			}
+		}
+	}
+	if f.hailmary != "" {
+		final, err := pick(f.hailmary)
+		if err != nil {
+			return nil, err
+		}
+		c = &strategyConfig{"hail-mary", map[string]interface{}{"strategy": c, "final": final}}
+	}
+	if len(f.open) > 0 {
+		c = &strategyConfig{"fixed", map[string]interface{}{"open": f.open, "strategy": c}}
+	}
+	return c, nil
+}
+
+// strategyNames lists the names accepted by --strategy: registered
+// strategies that need no parameters, and scorings.
+func strategyNames() string {
+	var names []string
+	for _, def := range wordle.Strategies() {
+		required := false
+		for _, p := range def.Params {
+			required = required || p.Default == nil
+		}
+		if !required {
+			names = append(names, def.Name)
+		}
+	}
+	for _, def := range wordle.Scorings() {
+		names = append(names, def.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// printRegistry writes the registered strategies and scorings, with
+// their parameters.
+func printRegistry(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	printParams := func(params []wordle.Param) {
+		for _, p := range params {
+			var def string
+			if p.Default != nil {
+				def = fmt.Sprintf(" (default %v)", p.Default)
+			}
+			fmt.Fprintf(w, "    %s %s\t%s%s\n", p.Name, p.Kind, p.Description, def)
+		}
+	}
+	fmt.Fprintln(w, "Strategies:")
+	for _, def := range wordle.Strategies() {
+		fmt.Fprintf(w, "  %s\t%s\n", def.Name, def.Description)
+		printParams(def.Params)
+	}
+	fmt.Fprintln(w, "\nScorings:")
+	for _, def := range wordle.Scorings() {
+		fmt.Fprintf(w, "  %s\t%s\n", def.Name, def.Description)
+		printParams(def.Params)
+	}
+	w.Flush()
+}
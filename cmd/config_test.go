@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigUnmarshal(t *testing.T) {
+	var c strategyConfig
+	assert.Nil(t, json.Unmarshal([]byte(`{"name": "filtering", "threshold": 100, "fallback": {"name": "naive"}}`), &c))
+	assert.Equal(t, "filtering", c.Name)
+	assert.Equal(t, json.Number("100"), c.Args["threshold"])
+	assert.Equal(t, map[string]interface{}{"name": "naive"}, c.Args["fallback"])
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"threshold": 100}`), &c), "missing name")
+}
+
+func TestFlagsConfig(t *testing.T) {
+	flags := strategyFlags{
+		fallback:  "freq",
+		hailmary:  "diversity",
+		score:     "random",
+		exp:       1.5,
+		threshold: 100,
+		open:      []string{"crane"},
+	}
+	c, err := flags.config("entropy")
+	assert.Nil(t, err)
+	assert.Equal(t, &strategyConfig{"fixed", map[string]interface{}{
+		"open": []string{"crane"},
+		"strategy": &strategyConfig{"hail-mary", map[string]interface{}{
+			"strategy": &strategyConfig{"entropy", map[string]interface{}{
+				"fallback":  &strategyConfig{"weighted", map[string]interface{}{"scoring": "freq", "exp": 1.5}},
+				"threshold": 100,
+			}},
+			"final": &strategyConfig{"weighted", map[string]interface{}{"scoring": "diversity", "exp": 1.5}},
+		}},
+	}}, c)
+
+	flags = strategyFlags{fallback: "naive", score: "top"}
+	c, err = flags.config("common")
+	assert.Nil(t, err)
+	assert.Equal(t, &strategyConfig{"top", map[string]interface{}{"scoring": "common"}}, c)
+
+	flags.score = "best"
+	_, err = flags.config("common")
+	assert.NotNil(t, err)
+}
+
+func TestBuildConfig(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	b := &builder{&wordle.Env{
+		Words:   words,
+		Answers: words,
+		Log:     &testLogger{},
+		WordFrequencies: func() (map[wordle.Word]float64, error) {
+			return readWordFreqCSV("../word_freq.csv")
+		},
+	}}
+	answer, _ := wordle.ParseWord("cigar")
+	for _, path := range []string{"../configs/filtering.json", "../configs/optimal.json"} {
+		c, err := readConfig(path)
+		assert.Nil(t, err, path)
+		strategy, err := b.strategy(c, rand.New(rand.NewSource(1)))
+		assert.Nil(t, err, path)
+		game := wordle.NewGame(words, nil)
+		assert.Nil(t, play(&game, strategy, wordle.NewAnswerHost(answer)), path)
+		assert.True(t, game.Won(), path)
+	}
+
+	build := func(config string) error {
+		var c strategyConfig
+		assert.Nil(t, json.Unmarshal([]byte(config), &c))
+		_, err := b.strategy(&c, rand.New(rand.NewSource(1)))
+		return err
+	}
+	assert.Nil(t, build(`{"name": "top", "scoring": {"name": "freq", "default": 2}}`))
+	assert.EqualError(t, build(`{"name": "hail-mary"}`), "hail-mary: missing strategy")
+	assert.EqualError(t, build(`{"name": "top", "scoring": "best"}`), "Unrecognized scoring: best")
+	assert.EqualError(t, build(`{"name": "filtering", "threshold": 1.5}`),
+		"filtering: threshold must be of type int, not json.Number")
+	assert.EqualError(t, build(`{"name": "naive", "exp": 2}`), "naive: unknown parameter exp")
+	assert.EqualError(t, build(`{"name": "fixed", "open": ["cran"], "strategy": "naive"}`),
+		"fixed: open: cran: Words must be 5 letters.")
+}
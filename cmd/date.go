@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseDate reads a --date option: a date like 2022-01-31, or "today"
+// or "yesterday" relative to now.
+func parseDate(s string, now time.Time) (time.Time, error) {
+	switch s {
+	case "today":
+		return now, nil
+	case "yesterday":
+		return now.AddDate(0, 0, -1), nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", s, now.Location())
+	if err != nil {
+		return date, fmt.Errorf("%s: dates must look like 2022-01-31, today or yesterday", s)
+	}
+	return date, nil
+}
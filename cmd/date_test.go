@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 8, 0, 0, 0, time.Local)
+	date, err := parseDate("today", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, date)
+	date, err = parseDate("yesterday", now)
+	assert.Nil(t, err)
+	assert.Equal(t, "2022-02-28", date.Format("2006-01-02"))
+	date, err = parseDate("2021-06-19", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, time.June, 19, 0, 0, 0, 0, time.Local), date)
+	_, err = parseDate("6/19/21", now)
+	assert.EqualError(t, err, "6/19/21: dates must look like 2022-01-31, today or yesterday")
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/jlgale/wordle"
+)
+
+// printCandidates writes a table of the candidates a strategy chose
+// among.
+func printCandidates(out io.Writer, candidates []wordle.Candidate) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(w, "Candidate\tScore\tExpected\tWorst\tEntropy\tP(answer)\t\n")
+	for _, c := range candidates {
+		fmt.Fprintf(w, "%s\t%0.3f\t%0.2f\t%d\t%0.2f\t%0.2f\t\n",
+			c.Word, c.Score, c.ExpectedRemaining, c.WorstCase, c.Entropy, c.Probability)
+	}
+	w.Flush()
+}
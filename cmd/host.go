@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jlgale/wordle"
+)
+
+// ANSI escapes for the colored squares.
+const (
+	ansiGreen  = "\x1b[1;30;42m"
+	ansiYellow = "\x1b[1;30;43m"
+	ansiGrey   = "\x1b[1;37;100m"
+	ansiReset  = "\x1b[0m"
+)
+
+// keyState is what the guesses so far reveal about a letter, in order
+// of how much they reveal.
+type keyState int
+
+const (
+	keyUnknown keyState = iota
+	keyGrey
+	keyYellow
+	keyGreen
+)
+
+// keyboard is the state of each letter, shown as the official game
+// colors its on-screen keyboard.
+type keyboard [26]keyState
+
+var keyboardRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// add records what a guess revealed. A letter stays at its most
+// revealing state, so a grey repeat of a colored letter doesn't hide
+// it.
+func (k *keyboard) add(g wordle.Guess) {
+	m := g.Match.String()
+	for idx, l := range g.Word {
+		state := keyGrey
+		switch m[idx] {
+		case 'G':
+			state = keyGreen
+		case 'y':
+			state = keyYellow
+		}
+		if state > k[l-'a'] {
+			k[l-'a'] = state
+		}
+	}
+}
+
+// hoster plays the part of the official game for someone guessing at
+// the console.
+type hoster struct {
+	console
+	// Words that can be played
+	known map[wordle.Word]bool
+	color bool
+}
+
+func newHoster(in io.Reader, out io.Writer, words []wordle.Word, color bool) *hoster {
+	return &hoster{newConsole(in, out), knownWords(words), color}
+}
+
+// play hosts the game with the given answer to the end, or until the
+// input ends.
+func (h *hoster) play(game *wordle.Game, answer wordle.Word) {
+	host := wordle.NewAnswerHost(answer)
+	var keys keyboard
+	for !game.Over() {
+		line, ok := h.prompt(fmt.Sprintf("Guess %d of %d: ", len(game.Guesses)+1, game.Limit()))
+		if !ok {
+			return
+		}
+		guess, err := h.parse(game, line)
+		if err != nil {
+			fmt.Fprintln(h.out, err)
+			continue
+		}
+		*game = game.Guess(guess, host.Reply(game, guess))
+		keys.add(game.Guesses[len(game.Guesses)-1])
+		for _, g := range game.Guesses {
+			h.printGuess(g)
+		}
+		if !game.Over() {
+			fmt.Fprintln(h.out)
+			h.printKeyboard(&keys)
+			fmt.Fprintln(h.out)
+		}
+	}
+	if game.Won() {
+		fmt.Fprintln(h.out, praise(len(game.Guesses)))
+	} else {
+		fmt.Fprintln(h.out, "The answer was", strings.ToUpper(answer.String()))
+	}
+}
+
+// parse reads a guess, checking that it can be played.
+func (h *hoster) parse(game *wordle.Game, line string) (wordle.Word, error) {
+	guess, err := wordle.ParseWord(strings.ToLower(line))
+	if err != nil {
+		return guess, err
+	}
+	if !h.known[guess] {
+		return guess, fmt.Errorf("%s: not in the word list", guess)
+	}
+	if err := game.CheckGuess(guess); err != nil {
+		return guess, fmt.Errorf("%s: %w", guess, err)
+	}
+	return guess, nil
+}
+
+// printGuess prints a guess as a row of colored squares or, without
+// color, as the word and its Match.
+func (h *hoster) printGuess(g wordle.Guess) {
+	word := strings.ToUpper(g.Word.String())
+	if !h.color {
+		fmt.Fprintln(h.out, word, g.Match)
+		return
+	}
+	m := g.Match.String()
+	var b strings.Builder
+	for idx := range word {
+		switch m[idx] {
+		case 'G':
+			b.WriteString(ansiGreen)
+		case 'y':
+			b.WriteString(ansiYellow)
+		default:
+			b.WriteString(ansiGrey)
+		}
+		fmt.Fprintf(&b, " %c ", word[idx])
+	}
+	b.WriteString(ansiReset)
+	fmt.Fprintln(h.out, b.String())
+}
+
+// printKeyboard prints the letters in keyboard layout. Without color,
+// letters known to be in the answer are capitalized and those known
+// not to be are replaced with a dot.
+func (h *hoster) printKeyboard(keys *keyboard) {
+	for idx, row := range keyboardRows {
+		var b strings.Builder
+		b.WriteString(strings.Repeat(" ", idx))
+		for _, l := range row {
+			state := keys[l-'a']
+			switch {
+			case h.color && state == keyGreen:
+				fmt.Fprintf(&b, "%s%c%s ", ansiGreen, l-'a'+'A', ansiReset)
+			case h.color && state == keyYellow:
+				fmt.Fprintf(&b, "%s%c%s ", ansiYellow, l-'a'+'A', ansiReset)
+			case h.color && state == keyGrey:
+				fmt.Fprintf(&b, "%s%c%s ", ansiGrey, l-'a'+'A', ansiReset)
+			case h.color || state == keyUnknown:
+				fmt.Fprintf(&b, "%c ", l)
+			case state == keyGrey:
+				b.WriteString(". ")
+			default:
+				fmt.Fprintf(&b, "%c ", l-'a'+'A')
+			}
+		}
+		fmt.Fprintln(h.out, strings.TrimRight(b.String(), " "))
+	}
+}
+
+// isTerminal tells if f is a terminal, and so can show colors.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHost(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	mkw := func(s string) wordle.Word {
+		w, err := wordle.ParseWord(s)
+		assert.Nil(t, err)
+		return w
+	}
+
+	var out bytes.Buffer
+	game := wordle.NewGame(words, nil)
+	input := "crane\nxyzzy\nSALTY\ncigar\n"
+	newHoster(strings.NewReader(input), &out, words, false).play(&game, mkw("cigar"))
+	assert.True(t, game.Won())
+	assert.Len(t, game.Guesses, 3)
+	assert.Contains(t, out.String(), "xyzzy: not in the word list")
+	assert.Contains(t, out.String(), "CRANE Gyy..\nSALTY .y...\n\n"+
+		"q w . R . . u i o p\n"+
+		" A . d f g h j k .\n"+
+		"  z x C v b . m\n")
+	assert.Contains(t, out.String(), "CIGAR GGGGG\nImpressive\n")
+
+	// Hard mode, in color, until the input ends.
+	out.Reset()
+	game = wordle.NewGame(words, nil)
+	game.SetHardMode(true)
+	newHoster(strings.NewReader("crane\nsalty\n"), &out, words, true).play(&game, mkw("cigar"))
+	assert.Len(t, game.Guesses, 1)
+	assert.Contains(t, out.String(), ansiGreen+" C "+ansiYellow+" R "+ansiYellow+" A "+
+		ansiGrey+" N "+ansiGrey+" E "+ansiReset)
+	assert.Contains(t, out.String(), "salty: 1st letter must be C")
+
+	// Losing shows the answer.
+	out.Reset()
+	game = wordle.NewGame(words, nil)
+	input = strings.Repeat("crane\n", wordle.GuessLimit)
+	newHoster(strings.NewReader(input), &out, words, false).play(&game, mkw("cigar"))
+	assert.False(t, game.Won())
+	assert.Contains(t, out.String(), "The answer was CIGAR")
+}
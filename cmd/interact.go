@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jlgale/wordle"
+)
+
+// console reads lines typed in reply to prompts.
+type console struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+func newConsole(in io.Reader, out io.Writer) console {
+	return console{bufio.NewScanner(in), out}
+}
+
+// prompt asks for a line of input, returning false when there's no
+// more.
+func (c console) prompt(s string) (string, bool) {
+	fmt.Fprint(c.out, s)
+	if !c.in.Scan() {
+		fmt.Fprintln(c.out)
+		return "", false
+	}
+	return strings.TrimSpace(c.in.Text()), true
+}
+
+// knownWords returns the set of the given words.
+func knownWords(words []wordle.Word) map[wordle.Word]bool {
+	known := make(map[wordle.Word]bool, len(words))
+	for _, w := range words {
+		known[w] = true
+	}
+	return known
+}
+
+// praise returns what the official game says on winning in the given
+// number of guesses.
+func praise(guesses int) string {
+	switch guesses {
+	case 1:
+		return "Genius"
+	case 2:
+		return "Magnificent"
+	case 3:
+		return "Impressive"
+	case 4:
+		return "Splendid"
+	case 5:
+		return "Great"
+	default:
+		return "Phew"
+	}
+}
+
+// interactor suggests guesses for a game played elsewhere, reading
+// back the matches.
+type interactor struct {
+	console
+	strategy wordle.Strategy
+	// Words that can be played
+	known map[wordle.Word]bool
+	// Number of candidates to show with each suggestion
+	explain int
+}
+
+func newInteractor(in io.Reader, out io.Writer, strategy wordle.Strategy, words []wordle.Word, explain int) *interactor {
+	return &interactor{newConsole(in, out), strategy, knownWords(words), explain}
+}
+
+// play plays the game to the end, or until the input ends.
+func (it *interactor) play(game *wordle.Game) {
+	for !game.Over() {
+		if len(game.PossibleAnswers()) == 0 && !it.recover(game) {
+			return
+		}
+		var guess wordle.Word
+		if it.explain > 0 {
+			var candidates []wordle.Candidate
+			guess, candidates = wordle.Explain(it.strategy, game, it.explain)
+			printCandidates(it.out, candidates)
+		} else {
+			guess = it.strategy.Guess(game)
+		}
+		fmt.Fprintln(it.out, "My guess", guess)
+		for {
+			line, ok := it.prompt(`describe match, or word:match for a word you played instead ` +
+				`(or "again" for a different guess, "undo" to take back the last guess): `)
+			if !ok {
+				return
+			}
+			switch strings.ToLower(line) {
+			case "again":
+				// In case the chosen word is not allowed
+				game.RemoveWord(guess)
+			case "undo":
+				if len(game.Guesses) == 0 {
+					fmt.Fprintln(it.out, "Nothing to undo")
+					continue
+				}
+				*game = game.Undo()
+			default:
+				played, err := it.parse(game, guess, line)
+				if err != nil {
+					fmt.Fprintln(it.out, err)
+					continue
+				}
+				*game = game.Guess(played.Word, played.Match)
+			}
+			break
+		}
+	}
+	if game.Won() {
+		fmt.Fprintln(it.out, praise(len(game.Guesses)))
+	} else {
+		fmt.Fprintln(it.out, "Out of guesses")
+	}
+}
+
+// recover offers to fix the likeliest mistyped match once the matches
+// rule out every answer. It returns false when the input ends.
+func (it *interactor) recover(game *wordle.Game) bool {
+	fmt.Fprintln(it.out, "No answers match, so a match may have been entered wrong")
+	revision, ok := game.SuggestRevision()
+	if !ok {
+		fmt.Fprintln(it.out, `Type "undo" to take back the last guess`)
+		return true
+	}
+	g := game.Guesses[revision.Index]
+	line, ok := it.prompt(fmt.Sprintf("Was %s %s rather than %s? That leaves %d possible answers [y/n]: ",
+		g.Word, revision.Match, g.Match, revision.Possible))
+	if !ok {
+		return false
+	}
+	if strings.HasPrefix(strings.ToLower(line), "y") {
+		*game = game.Revise(revision.Index, revision.Match)
+	}
+	return true
+}
+
+// parse reads what was played: the match for our guess, or the word
+// the player chose instead and its match.
+func (it *interactor) parse(game *wordle.Game, guess wordle.Word, line string) (wordle.Guess, error) {
+	if !strings.Contains(line, ":") {
+		match, err := wordle.ParseMatch(line)
+		return wordle.Guess{Word: guess, Match: match}, err
+	}
+	played, err := wordle.ParseGuess(line)
+	if err != nil {
+		return played, err
+	}
+	if !it.known[played.Word] {
+		return played, fmt.Errorf("%s: not in the word list", played.Word)
+	}
+	if err := game.CheckGuess(played.Word); err != nil {
+		return played, fmt.Errorf("%s: %w", played.Word, err)
+	}
+	return played, nil
+}
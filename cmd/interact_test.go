@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInteract(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	mkw := func(s string) wordle.Word {
+		w, err := wordle.ParseWord(s)
+		assert.Nil(t, err)
+		return w
+	}
+	answer := mkw("cigar")
+	open := []wordle.Word{mkw("crane"), mkw("lousy"), mkw("tipsy")}
+	strategy := wordle.FixedStrategy(open, wordle.NaiveStrategy(rand.New(rand.NewSource(1))))
+
+	input := strings.Join([]string{
+		mkw("crane").Match(answer).String(),
+		// Played another word instead
+		"xyzzy:.....",
+		"lousy:gg",
+		"pilot:" + mkw("pilot").Match(answer).String(),
+		// Not allowed, but didn't say so
+		"again",
+		"",
+	}, "\n")
+	var out bytes.Buffer
+	game := wordle.NewGame(words, nil)
+	newInteractor(strings.NewReader(input), &out, strategy, words, 0).play(&game)
+	assert.Len(t, game.Guesses, 2)
+	assert.Equal(t, mkw("pilot"), game.Guesses[1].Word)
+	assert.Contains(t, out.String(), "My guess lousy")
+	assert.Contains(t, out.String(), "xyzzy: not in the word list")
+	assert.Contains(t, out.String(), "Unrecognized match description")
+	// The opening was abandoned once another word was played.
+	assert.NotContains(t, out.String(), "My guess tipsy")
+
+	// Hard mode applies to words played instead.
+	game = wordle.NewGame(words, nil)
+	game.SetHardMode(true)
+	input = "crane:g....\nfjord:.....\n"
+	out.Reset()
+	newInteractor(strings.NewReader(input), &out, strategy, words, 0).play(&game)
+	assert.Len(t, game.Guesses, 1)
+	assert.Contains(t, out.String(), "fjord: 1st letter must be C")
+
+	// Play to the end.
+	game = wordle.NewGame(words, nil)
+	input = "cigar:ggggg\n"
+	out.Reset()
+	newInteractor(strings.NewReader(input), &out, strategy, words, 3).play(&game)
+	assert.True(t, game.Won())
+	assert.Contains(t, out.String(), "Candidate")
+	assert.Contains(t, out.String(), "Genius")
+
+	// Undo, and recover from a mistyped match.
+	game = wordle.NewGame(words, nil)
+	input = "undo\n" + mkw("crane").Match(answer).String() + "\nundo\ncrane:ggggy\ny\n"
+	out.Reset()
+	newInteractor(strings.NewReader(input), &out, strategy, words, 0).play(&game)
+	assert.Contains(t, out.String(), "Nothing to undo")
+	assert.Contains(t, out.String(), "Was crane GGGG. rather than GGGGy?")
+	assert.Len(t, game.Guesses, 1)
+	assert.Equal(t, "GGGG.", game.Guesses[0].Match.String())
+	assert.NotEmpty(t, game.PossibleAnswers())
+}
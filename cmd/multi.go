@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/jlgale/wordle"
+)
+
+// playMulti plays a multi-board game automatically, with one answer
+// per board.
+func playMulti(m *wordle.MultiGame, strategy wordle.MultiStrategy, answers []wordle.Word) {
+	for !m.Over() {
+		guess := strategy.Guess(m)
+		matches := make([]wordle.Match, len(answers))
+		for idx, answer := range answers {
+			matches[idx] = guess.Match(answer)
+		}
+		*m = m.Guess(guess, matches)
+	}
+}
+
+// playMultiGames plays one multi-board game for each group of
+// answers. With repeat 0 each game is printed; otherwise each group is
+// played repeat times, each game with its own seed, spread across the
+// given number of workers, and a summary is printed.
+func playMultiGames(
+	groups [][]wordle.Word, repeat, workers int, seed int64,
+	newGame func() wordle.MultiGame,
+	newStrategy func(rng *rand.Rand) (wordle.MultiStrategy, error),
+) error {
+	if repeat == 0 {
+		strategy, err := newStrategy(rand.New(rand.NewSource(seed)))
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			m := newGame()
+			playMulti(&m, strategy, group)
+			fmt.Println(m)
+			if !m.Won() {
+				fmt.Println("The answers were:", group)
+			}
+		}
+		return nil
+	}
+	var results = make([]wordle.MultiGame, repeat*len(groups))
+	err := runJobs(len(results), workers, func(idx int) (func(), error) {
+		rep, group := idx/len(groups), groups[idx%len(groups)]
+		strategy, err := newStrategy(rand.New(rand.NewSource(gameSeed(seed, group[0], rep))))
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			results[idx] = newGame()
+			playMulti(&results[idx], strategy, group)
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	var games, wins, guesses, boardsWon, boards int
+	for _, m := range results {
+		games++
+		if m.Won() {
+			wins++
+		}
+		guesses += len(m.Guesses)
+		boards += len(m.Boards)
+		boardsWon += len(m.Boards) - len(m.Unsolved())
+	}
+	fmt.Printf("Won %d of %d games (%0.1f%%), %d of %d boards. Guesses: avg %0.1f\n",
+		wins, games, float64(wins)/float64(games)*100, boardsWon, boards,
+		float64(guesses)/float64(games))
+	return nil
+}
+
+// interactMulti plays a multi-board game, asking for the match on
+// each unsolved board after every guess.
+func interactMulti(m *wordle.MultiGame, strategy wordle.MultiStrategy) {
+	for !m.Over() {
+		guess := strategy.Guess(m)
+		fmt.Println("My guess", guess)
+		matches := make([]wordle.Match, len(m.Boards))
+		again := false
+		for _, idx := range m.Unsolved() {
+			for {
+				var matchString string
+				fmt.Printf(`describe match on board %d (or "again" for a different guess): `, idx+1)
+				fmt.Scanf("%s", &matchString)
+				// In case the chosen word is not allowed:
+				if strings.ToLower(matchString) == "again" {
+					again = true
+					break
+				}
+				match, err := wordle.ParseMatch(matchString)
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+				matches[idx] = match
+				break
+			}
+			if again {
+				break
+			}
+		}
+		if again {
+			m.RemoveWord(guess)
+			continue
+		}
+		*m = m.Guess(guess, matches)
+	}
+	if m.Won() {
+		fmt.Printf("Solved %d boards in %d guesses\n", len(m.Boards), len(m.Guesses))
+	} else {
+		fmt.Printf("Solved %d of %d boards\n", len(m.Boards)-len(m.Unsolved()), len(m.Boards))
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jlgale/wordle"
+)
+
+// histogram counts games by the number of guesses taken to win them,
+// with lost games in the last bucket.
+type histogram [wordle.GuessLimit + 1]int
+
+func (h *histogram) add(game wordle.Game) {
+	if game.Won() {
+		h[len(game.Guesses)-1]++
+	} else {
+		h[wordle.GuessLimit]++
+	}
+}
+
+// print writes one line per bucket, with a bar scaled to the largest.
+func (h *histogram) print(out io.Writer) {
+	const width = 50
+	var largest int
+	for _, count := range h {
+		if count > largest {
+			largest = count
+		}
+	}
+	for idx, count := range h {
+		label := strconv.Itoa(idx + 1)
+		if idx == wordle.GuessLimit {
+			label = "X"
+		}
+		var bar int
+		if largest > 0 {
+			bar = (count*width + largest - 1) / largest
+		}
+		line := fmt.Sprintf("%s: %6d %s", label, count, strings.Repeat("#", bar))
+		fmt.Fprintln(out, strings.TrimRight(line, " "))
+	}
+}
+
+// gameRecord is a played game, as written by --output.
+type gameRecord struct {
+	Answer  string   `json:"answer"`
+	Seed    int64    `json:"seed"`
+	Guesses []string `json:"guesses"`
+	Matches []string `json:"matches"`
+	Won     bool     `json:"won"`
+}
+
+func newGameRecord(r gameResult) gameRecord {
+	rec := gameRecord{
+		Answer:  r.answer.String(),
+		Seed:    r.seed,
+		Guesses: make([]string, len(r.game.Guesses)),
+		Matches: make([]string, len(r.game.Guesses)),
+		Won:     r.game.Won(),
+	}
+	if r.answer == (wordle.Word{}) {
+		// The adversarial host has no answer until it's forced
+		// to concede one.
+		rec.Answer = ""
+		if rec.Won {
+			rec.Answer = r.game.Guesses[len(r.game.Guesses)-1].Word.String()
+		}
+	}
+	for idx, g := range r.game.Guesses {
+		rec.Guesses[idx] = g.Word.String()
+		rec.Matches[idx] = g.Match.String()
+	}
+	return rec
+}
+
+// checkFormat returns an error if writeResults doesn't support the
+// format, so that it can be checked before any games are played.
+func checkFormat(format string) error {
+	switch strings.ToLower(format) {
+	case "json", "csv":
+		return nil
+	}
+	return fmt.Errorf("Unrecognized output format: %s", format)
+}
+
+// writeResults writes one record per game in the given format: "json"
+// for a JSON object per line, or "csv" for a header and then a row
+// per game, with guesses and matches space separated.
+func writeResults(out io.Writer, format string, results []gameResult) error {
+	if err := checkFormat(format); err != nil {
+		return err
+	}
+	switch strings.ToLower(format) {
+	case "json":
+		enc := json.NewEncoder(out)
+		for _, r := range results {
+			if err := enc.Encode(newGameRecord(r)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "csv":
+		w := csv.NewWriter(out)
+		w.Write([]string{"answer", "seed", "guesses", "matches", "won"})
+		for _, r := range results {
+			rec := newGameRecord(r)
+			w.Write([]string{
+				rec.Answer,
+				strconv.FormatInt(rec.Seed, 10),
+				strings.Join(rec.Guesses, " "),
+				strings.Join(rec.Matches, " "),
+				strconv.FormatBool(rec.Won),
+			})
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return nil
+}
+
+// printSummary writes the win rate and guess counts of the games,
+// then their histogram.
+func printSummary(out io.Writer, results []gameResult) {
+	var guesses int
+	var minGuesses int = 7
+	var maxGuesses int = 0
+	var wins int = 0
+	var hist histogram
+	for _, r := range results {
+		game := r.game
+		if game.Won() {
+			wins += 1
+		}
+		guesses += len(game.Guesses)
+		if len(game.Guesses) > maxGuesses {
+			maxGuesses = len(game.Guesses)
+		}
+		if len(game.Guesses) < minGuesses {
+			minGuesses = len(game.Guesses)
+		}
+		hist.add(game)
+	}
+	games := len(results)
+	fmt.Fprintf(out, "Won %d of %d games (%0.1f%%). Guesses: avg %0.1f, min %d, max %d\n",
+		wins, games, float64(wins)/float64(games)*100, float64(guesses)/float64(games),
+		minGuesses, maxGuesses)
+	hist.print(out)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func playedResult(t *testing.T, answer string, guesses ...string) gameResult {
+	a, err := wordle.ParseWord(answer)
+	assert.Nil(t, err)
+	game := wordle.NewGame(nil, nil)
+	for _, s := range guesses {
+		w, err := wordle.ParseWord(s)
+		assert.Nil(t, err)
+		game = game.Guess(w, w.Match(a))
+	}
+	return gameResult{answer: a, seed: 7, game: game}
+}
+
+func TestHistogram(t *testing.T) {
+	var h histogram
+	h.add(playedResult(t, "cigar", "crane", "cigar").game)
+	h.add(playedResult(t, "cigar", "cigar").game)
+	h.add(playedResult(t, "cigar", "aaaaa", "aaaaa", "aaaaa", "aaaaa", "aaaaa", "aaaaa").game)
+	assert.Equal(t, histogram{1, 1, 0, 0, 0, 0, 1}, h)
+
+	var out bytes.Buffer
+	h.print(&out)
+	bar := strings.Repeat("#", 50)
+	assert.Equal(t, "1:      1 "+bar+"\n2:      1 "+bar+"\n3:      0\n4:      0\n5:      0\n6:      0\nX:      1 "+bar+"\n",
+		out.String())
+}
+
+func TestWriteResults(t *testing.T) {
+	results := []gameResult{
+		playedResult(t, "cigar", "crane", "cigar"),
+		playedResult(t, "rebut", "crane"),
+	}
+
+	var out bytes.Buffer
+	assert.Nil(t, writeResults(&out, "csv", results))
+	assert.Equal(t, "answer,seed,guesses,matches,won\n"+
+		"cigar,7,crane cigar,Gyy.. GGGGG,true\n"+
+		"rebut,7,crane,.y..y,false\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, writeResults(&out, "json", results))
+	dec := json.NewDecoder(&out)
+	var rec gameRecord
+	assert.Nil(t, dec.Decode(&rec))
+	assert.Equal(t, gameRecord{"cigar", 7, []string{"crane", "cigar"}, []string{"Gyy..", "GGGGG"}, true}, rec)
+	assert.Nil(t, dec.Decode(&rec))
+	assert.Equal(t, "rebut", rec.Answer)
+	assert.False(t, rec.Won)
+
+	assert.Nil(t, checkFormat("JSON"))
+	assert.EqualError(t, checkFormat("xml"), "Unrecognized output format: xml")
+	assert.NotNil(t, writeResults(&out, "xml", results))
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+
+	"github.com/jlgale/wordle"
+)
+
+// gameResult is the outcome of one automatically played game.
+type gameResult struct {
+	answer wordle.Word
+	// Repetition of this answer, from 0.
+	rep  int
+	seed int64
+	game wordle.Game
+	err  error
+}
+
+// gameSeed derives the seed for a single game from the root seed, the
+// answer and the repetition, so that a game plays the same no matter
+// which worker plays it or in which order.
+func gameSeed(seed int64, answer wordle.Word, rep int) int64 {
+	h := fnv.New64a()
+	binary.Write(h, binary.LittleEndian, seed)
+	h.Write(answer[:])
+	binary.Write(h, binary.LittleEndian, int64(rep))
+	return int64(h.Sum64())
+}
+
+// checkAnswers returns an error if any of the targets isn't among the
+// answers a game can have.
+func checkAnswers(targets, answers []wordle.Word) error {
+	possible := knownWords(answers)
+	for _, answer := range targets {
+		if !possible[answer] {
+			return fmt.Errorf("%s: not in the answer list, or already used", answer)
+		}
+	}
+	return nil
+}
+
+// playAll plays each answer repeat times, spread across the given
+// number of workers. Every game gets its own strategy, built with a
+// *rand.Rand seeded by gameSeed, and its own host for the answer.
+// Results are ordered by repetition, then by answer.
+//
+// Strategies are built serially, so newStrategy needn't be safe for
+// concurrent use, but anything they share must be.
+func playAll(
+	answers []wordle.Word, repeat, workers int, seed int64,
+	newGame func() wordle.Game,
+	newStrategy func(rng *rand.Rand) (wordle.Strategy, error),
+	newHost func(answer wordle.Word) wordle.Host,
+) ([]gameResult, error) {
+	var results = make([]gameResult, repeat*len(answers))
+	err := runJobs(len(results), workers, func(idx int) (func(), error) {
+		rep, answer := idx/len(answers), answers[idx%len(answers)]
+		s := gameSeed(seed, answer, rep)
+		results[idx] = gameResult{answer: answer, rep: rep, seed: s}
+		strategy, err := newStrategy(rand.New(rand.NewSource(s)))
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			r := &results[idx]
+			r.game = newGame()
+			r.err = play(&r.game, strategy, newHost(r.answer))
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		if r.err != nil {
+			return nil, fmt.Errorf("%s: %w", r.answer, r.err)
+		}
+	}
+	return results, nil
+}
+
+// runJobs runs n jobs spread across the given number of workers. Each
+// job is made by calling build with its index, in order, so build
+// needn't be safe for concurrent use. Making jobs stops at the first
+// error, which is returned once the jobs already made have run.
+func runJobs(n, workers int, build func(idx int) (func(), error)) error {
+	var jobs = make(chan func())
+	var wg sync.WaitGroup
+	if workers < 1 {
+		workers = 1
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job()
+			}
+		}()
+	}
+	var err error
+	for idx := 0; idx < n; idx++ {
+		var job func()
+		job, err = build(idx)
+		if err != nil {
+			break
+		}
+		jobs <- job
+	}
+	close(jobs)
+	wg.Wait()
+	return err
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayAllReproducible(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	answers, err := readWordFile("../test_answers", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	newGame := func() wordle.Game { return wordle.NewGame(words, nil) }
+	newStrategy := func(rng *rand.Rand) (wordle.Strategy, error) {
+		return wordle.NaiveStrategy(rng), nil
+	}
+	type key struct {
+		answer wordle.Word
+		rep    int
+		seed   int64
+	}
+	newHost := func(answer wordle.Word) wordle.Host {
+		return wordle.NewAnswerHost(answer)
+	}
+	guesses := func(results []gameResult) map[key]string {
+		m := make(map[key]string)
+		for _, r := range results {
+			m[key{r.answer, r.rep, r.seed}] = r.game.String()
+		}
+		return m
+	}
+
+	serial, err := playAll(answers, 3, 1, 42, newGame, newStrategy, newHost)
+	assert.Nil(t, err)
+	assert.Len(t, serial, 3*len(answers))
+	expect := guesses(serial)
+
+	parallel, err := playAll(answers, 3, 4, 42, newGame, newStrategy, newHost)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, guesses(parallel))
+
+	reversed := make([]wordle.Word, len(answers))
+	for i, a := range answers {
+		reversed[len(answers)-1-i] = a
+	}
+	shuffled, err := playAll(reversed, 3, 3, 42, newGame, newStrategy, newHost)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, guesses(shuffled))
+
+	other, err := playAll(answers, 3, 1, 43, newGame, newStrategy, newHost)
+	assert.Nil(t, err)
+	assert.NotEqual(t, expect, guesses(other))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/jlgale/wordle"
+)
+
+// search returns the words matching the pattern, as parsed by
+// wordle.ParsePattern, best scoring first.
+func search(words []wordle.Word, pattern string, scoring wordle.Scoring) ([]wordle.Word, error) {
+	c, err := wordle.ParsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	found := c.Filter(words)
+	weights := scoring.Weights(found)
+	index := make([]int, len(found))
+	for idx := range index {
+		index[idx] = idx
+	}
+	sort.SliceStable(index, func(i, j int) bool {
+		return weights[index[i]] > weights[index[j]]
+	})
+	sorted := make([]wordle.Word, len(found))
+	for idx, i := range index {
+		sorted[idx] = found[i]
+	}
+	return sorted, nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	mkw := func(s string) wordle.Word {
+		w, err := wordle.ParseWord(s)
+		assert.Nil(t, err)
+		return w
+	}
+	freq := wordle.NewFreq(map[wordle.Word]float64{mkw("stare"): 2, mkw("spare"): 3}, 1)
+
+	found, err := search(words, "s?a?e +r -h", freq)
+	assert.Nil(t, err)
+	assert.Equal(t, []wordle.Word{mkw("spare"), mkw("stare")}, found[:2])
+	assert.Contains(t, found, mkw("snare"))
+	assert.NotContains(t, found, mkw("share"))
+
+	found, err = search(words, "qq???", freq)
+	assert.Nil(t, err)
+	assert.Empty(t, found)
+
+	_, err = search(words, "s?a?", freq)
+	assert.EqualError(t, err, "Unrecognized pattern: s?a?")
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jlgale/wordle"
+)
+
+// solveRequest is the body of a POST to the solver service.
+type solveRequest struct {
+	// Guesses so far, each as word:match, for example "crane:..y.g"
+	Guesses []string `json:"guesses"`
+	Hard    bool     `json:"hard"`
+}
+
+type solveResponse struct {
+	Possible []string `json:"possible"`
+	// Recommended next guess, unless the game is over.
+	Guess  string           `json:"guess,omitempty"`
+	Won    bool             `json:"won"`
+	Scores []candidateScore `json:"scores,omitempty"`
+}
+
+type candidateScore struct {
+	Word string `json:"word"`
+	// The strategy's own measure of the candidate; see wordle.Candidate.
+	Score             float64 `json:"score"`
+	ExpectedRemaining float64 `json:"expected_remaining"`
+	WorstCase         int     `json:"worst_case"`
+	Entropy           float64 `json:"entropy"`
+	Probability       float64 `json:"probability"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// solveHandler serves the configured strategy over HTTP. Each request
+// gets a fresh strategy seeded with the same seed, so the same history
+// always gets the same recommendation.
+type solveHandler struct {
+	known       map[wordle.Word]bool
+	newGame     func() wordle.Game
+	newStrategy func(rng *rand.Rand) (wordle.Strategy, error)
+	seed        int64
+	// Number of the strategy's candidates to return in each response,
+	// or 0 for all
+	candidates int
+	// Time to search for a guess, or 0 for no limit. Strategies that
+	// support it answer with their best guess so far.
+	timeout time.Duration
+	// Guards newStrategy, which isn't safe for concurrent use.
+	mu sync.Mutex
+}
+
+func newSolveHandler(
+	words []wordle.Word,
+	newGame func() wordle.Game,
+	newStrategy func(rng *rand.Rand) (wordle.Strategy, error),
+	seed int64, candidates int, timeout time.Duration,
+) *solveHandler {
+	known := make(map[wordle.Word]bool, len(words))
+	for _, w := range words {
+		known[w] = true
+	}
+	return &solveHandler{
+		known:       known,
+		newGame:     newGame,
+		newStrategy: newStrategy,
+		seed:        seed,
+		candidates:  candidates,
+		timeout:     timeout,
+	}
+}
+
+func (h *solveHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"POST a JSON history of guesses"})
+		return
+	}
+	var req solveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{err.Error()})
+		return
+	}
+	ctx := r.Context()
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+	resp, status, err := h.solve(ctx, req)
+	if err != nil {
+		writeJSON(w, status, errorResponse{err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *solveHandler) solve(ctx context.Context, req solveRequest) (resp solveResponse, status int, err error) {
+	game := h.newGame()
+	game.SetHardMode(req.Hard)
+	for _, s := range req.Guesses {
+		g, err := wordle.ParseGuess(s)
+		if err != nil {
+			return resp, http.StatusBadRequest, fmt.Errorf("%s: %w", s, err)
+		}
+		if !h.known[g.Word] {
+			return resp, http.StatusBadRequest, fmt.Errorf("%s: not in the word list", g.Word)
+		}
+		if err := game.CheckGuess(g.Word); err != nil {
+			return resp, http.StatusBadRequest, fmt.Errorf("%s: %w", g.Word, err)
+		}
+		game = game.Guess(g.Word, g.Match)
+	}
+	possible := game.PossibleAnswers()
+	resp.Possible = make([]string, len(possible))
+	for idx, w := range possible {
+		resp.Possible[idx] = w.String()
+	}
+	resp.Won = game.Won()
+	if game.Over() {
+		return resp, http.StatusOK, nil
+	}
+	if len(possible) == 0 {
+		return resp, http.StatusUnprocessableEntity, fmt.Errorf("No possible answers, check the matches")
+	}
+
+	h.mu.Lock()
+	strategy, err := h.newStrategy(rand.New(rand.NewSource(h.seed)))
+	h.mu.Unlock()
+	if err != nil {
+		return resp, http.StatusInternalServerError, err
+	}
+	n := h.candidates
+	if n <= 0 {
+		n = math.MaxInt32 // every candidate
+	}
+	guess, candidates := wordle.ExplainContext(ctx, strategy, &game, n)
+	if err := game.CheckGuess(guess); err != nil {
+		return resp, http.StatusUnprocessableEntity,
+			fmt.Errorf("The strategy has no guess allowed in hard mode: %s: %w", guess, err)
+	}
+	resp.Guess = guess.String()
+	for _, c := range candidates {
+		resp.Scores = append(resp.Scores, candidateScore{
+			Word:              c.Word.String(),
+			Score:             c.Score,
+			ExpectedRemaining: c.ExpectedRemaining,
+			WorstCase:         c.WorstCase,
+			Entropy:           c.Entropy,
+			Probability:       c.Probability,
+		})
+	}
+	return resp, http.StatusOK, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jlgale/wordle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveHandler(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	answers, err := readWordFile("../test_answers", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	newGame := func() wordle.Game { return wordle.NewGameWithAnswers(words, answers, nil) }
+	newStrategy := func(rng *rand.Rand) (wordle.Strategy, error) {
+		return wordle.NewMinimaxStrategy(rng, &testLogger{}, wordle.NaiveStrategy(rng), 50,
+			wordle.NewUniqueLettersScoring()), nil
+	}
+	handler := newSolveHandler(words, newGame, newStrategy, 1, 3, 0)
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/solve", strings.NewReader(body)))
+		return w
+	}
+
+	w := post(`{"guesses": ["crane:..y.."]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp solveResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"affix", "madly"}, resp.Possible)
+	assert.False(t, resp.Won)
+	assert.NotEmpty(t, resp.Guess)
+	assert.NotEmpty(t, resp.Scores)
+	assert.LessOrEqual(t, len(resp.Scores), 3)
+	assert.Equal(t, resp.Guess, resp.Scores[0].Word)
+	// The candidates are the strategy's own, best first.
+	for idx := 1; idx < len(resp.Scores); idx++ {
+		assert.LessOrEqual(t, resp.Scores[idx-1].Score, resp.Scores[idx].Score)
+	}
+
+	// The same history gets the same recommendation.
+	var again solveResponse
+	assert.Nil(t, json.Unmarshal(post(`{"guesses": ["crane:..y.."]}`).Body.Bytes(), &again))
+	assert.Equal(t, resp, again)
+
+	w = post(`{"guesses": ["madly:ggggg"]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.Won)
+
+	assert.Equal(t, http.StatusBadRequest, post(`{"guesses": ["xyzzy:....."]}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"guesses": [`).Code)
+	assert.Equal(t, http.StatusBadRequest,
+		post(`{"guesses": ["crane:..y..", "fjord:....."], "hard": true}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, post(`{"guesses": ["crane:ggggy"]}`).Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/solve", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	// Out of time, the strategy falls back, and only its guess is
+	// scored.
+	handler.timeout = time.Nanosecond
+	w = post(`{"guesses": ["crane:..y.."]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	resp = solveResponse{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Contains(t, resp.Possible, resp.Guess)
+	assert.Len(t, resp.Scores, 1)
+}
+
+func TestSolveHandlerHardOptimal(t *testing.T) {
+	words, err := readWordFile("../words", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	answers, err := readWordFile("../test_answers", func(string, int, error) error { return nil })
+	assert.Nil(t, err)
+	newGame := func() wordle.Game { return wordle.NewGameWithAnswers(words, answers, nil) }
+	solver := wordle.NewSolver(words, wordle.TotalGuesses, 5)
+	hardSolver := wordle.NewSolver(words, wordle.TotalGuesses, 5)
+	hardSolver.SetHardMode(true)
+	newStrategy := func(rng *rand.Rand) (wordle.Strategy, error) {
+		optimal := wordle.NewOptimalStrategy(solver, wordle.NaiveStrategy(rng), 150)
+		optimal.SetHardSolver(hardSolver)
+		return optimal, nil
+	}
+	handler := newSolveHandler(words, newGame, newStrategy, 1, 3, 0)
+
+	w := httptest.NewRecorder()
+	body := `{"guesses": ["crane:..y.."], "hard": true}`
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/solve", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp solveResponse
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	// The hard mode solver only guesses possible answers.
+	assert.Contains(t, resp.Possible, resp.Guess)
+}
+
+type testLogger struct{}
+
+func (*testLogger) Printf(template string, args ...interface{}) {}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"reflect"
 	"runtime"
@@ -16,12 +18,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func play(game *wordle.Game, strategy wordle.Strategy, answer wordle.Word) {
+func play(game *wordle.Game, strategy wordle.Strategy, host wordle.Host) error {
 	for !game.Over() {
 		guess := strategy.Guess(game)
-		match := guess.Match(answer)
+		if err := game.CheckGuess(guess); err != nil {
+			return fmt.Errorf("%s: %w", guess, err)
+		}
+		match := host.Reply(game, guess)
 		*game = game.Guess(guess, match)
 	}
+	return nil
 }
 
 func main() {
@@ -37,9 +43,13 @@ func main() {
 	rootFlags := root.PersistentFlags()
 	wordsOpt := rootFlags.String("words", "./words",
 		"Path to accepted word list")
+	answersListOpt := rootFlags.String("answers-list", "",
+		"Path to possible answer list, if narrower than --words")
+	historyOpt := rootFlags.String("history", "",
+		"Path to a list of answers already used, which can't be the answer again")
 	seedOpt := rootFlags.Int64("seed", 0, "Random seed")
 	strategyOpt := rootFlags.StringP("strategy", "s", "filtering",
-		"Play strategy. One of: common, diversity, filtering, naive, selective")
+		"Play strategy. One of: "+strategyNames())
 	debugOpt := rootFlags.BoolP("debug", "d", false,
 		"Enable debug logging")
 	scoreOpt := rootFlags.String("score", "random",
@@ -62,22 +72,75 @@ func main() {
 	// difficult words (ex "watch")
 	fallbackThresholdOpt := rootFlags.Int("fallback-threshold", 150,
 		"Threshold where the fallback strategy is used")
+	workersOpt := rootFlags.Int("workers", 1,
+		"Goroutines used to evaluate candidate guesses of each game. "+
+			"play and compare already run --jobs games at once")
+	objectiveOpt := rootFlags.String("objective", "total",
+		"What the optimal strategy minimizes. One of: total, worst")
+	// 0 searches every word, which is truly optimal, and fast enough
+	// below --fallback-threshold answers.
+	solverCandidatesOpt := rootFlags.Int("solver-candidates", 0,
+		"Words the optimal strategy considers for each guess, or 0 for all. "+
+			"Fewer are faster, but the guesses are no longer optimal")
+	configOpt := rootFlags.String("config", "",
+		"Load the strategy from a JSON file, in place of the other strategy options")
+	multiStrategyOpt := rootFlags.String("multi-strategy", "focus",
+		"Strategy across boards with --boards. One of: focus, filtering")
 
 	// Hidden, debug type options
 	useCacheOpt := rootFlags.Bool("use-cache", true, "Use a scoring cache")
+	useMatchTableOpt := rootFlags.Bool("use-match-table", true,
+		"Use a table of precomputed matches")
 	cpuProfileOpt := rootFlags.String("cpu-profile", "",
 		"Profile CPU usage and write the given file")
 	memProfileOpt := rootFlags.String("mem-profile", "",
 		"Profile memory usage and write the given file")
 	rootFlags.MarkHidden("use-cache")
+	rootFlags.MarkHidden("use-match-table")
 	rootFlags.MarkHidden("cpu-profile")
 	rootFlags.MarkHidden("mem-profile")
 
 	// Setup common state
 	var words []wordle.Word
+	// Answers that can be played, less those already used
+	var answers []wordle.Word
 	var strategy wordle.Strategy
+	// Builds a strategy for a single game, using the given rng.
+	var newStrategy func(rng *rand.Rand) (wordle.Strategy, error)
+	// Like newStrategy, but for the named strategy rather than the
+	// --strategy option.
+	var newStrategyFor func(name string) func(rng *rand.Rand) (wordle.Strategy, error)
+	// Builds the strategies of --config files, and of the flags.
+	var strategyBuilder *builder
 	var log zerolog.Logger = zerolog.New(os.Stderr).Level(zerolog.InfoLevel)
 	var rng *rand.Rand
+	var table *wordle.MatchTable
+	// Builds a strategy for a single multi-board game.
+	var newMultiStrategy func(rng *rand.Rand) (wordle.MultiStrategy, error)
+	// Set by the --hard option of each command
+	var hard bool
+	// Set by the --boards and --guess-limit options of each command
+	var boards, guessLimit int
+	// Set by the --date and --exclude-past options of each command
+	var date string
+	var excludePast bool
+	// The answer on --date
+	var dailyAnswer wordle.Word
+	newGame := func() wordle.Game {
+		game := wordle.NewGameWithAnswers(words, answers, nil)
+		game.UseMatchTable(table)
+		game.SetHardMode(hard)
+		return game
+	}
+	newMultiGame := func() wordle.MultiGame {
+		limit := guessLimit
+		if limit <= 0 {
+			limit = wordle.MultiGuessLimit(boards)
+		}
+		m := wordle.NewMultiGame(boards, limit, words, answers, nil)
+		m.UseMatchTable(table)
+		return m
+	}
 	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		if *debugOpt {
 			log = log.Level(zerolog.DebugLevel)
@@ -91,164 +154,229 @@ func main() {
 			return err
 		}
 		log.Printf("%s: loaded %d words", *wordsOpt, len(words))
-		if *seedOpt == 0 {
-			*seedOpt = time.Now().UnixNano()
-			fmt.Printf("Rolling the dice: --seed=%d\n", *seedOpt)
-		}
-		rng = rand.New(rand.NewSource(*seedOpt))
-		var scoringfn func(s wordle.Scoring) wordle.Strategy
-		switch strings.ToLower(*scoreOpt) {
-		case "random":
-			scoringfn = func(s wordle.Scoring) wordle.Strategy {
-				return wordle.NewWeightedStrategy(rng, s, *expOpt)
-			}
-		case "top":
-			scoringfn = func(s wordle.Scoring) wordle.Strategy {
-				return wordle.NewTop(rng, s)
+		answers = words
+		if *answersListOpt != "" {
+			answers, err = readWordFile(*answersListOpt, func(word string, lineno int, err error) error {
+				log.Printf("%s:%d: %s: %v\n", *answersListOpt, lineno, word, err)
+				return nil
+			})
+			if err != nil {
+				return err
 			}
-		default:
-			return fmt.Errorf("Unrecognized scoring function: %s", *scoreOpt)
+			log.Printf("%s: loaded %d answers", *answersListOpt, len(answers))
 		}
-
-		if *useCacheOpt {
-			innerScoringFn := scoringfn
-			scoringfn = func(s wordle.Scoring) wordle.Strategy {
-				cache := wordle.NewScoringCache(s, words)
-				return innerScoringFn(cache)
+		// Answers already used, from --history and --exclude-past
+		var used []wordle.Word
+		if *historyOpt != "" {
+			used, err = readWordFile(*historyOpt, func(word string, lineno int, err error) error {
+				log.Printf("%s:%d: %s: %v\n", *historyOpt, lineno, word, err)
+				return nil
+			})
+			if err != nil {
+				return err
 			}
+			log.Printf("%s: loaded %d used answers", *historyOpt, len(used))
 		}
-
-		if *debugOpt {
-			// Wrap a logger around the scale function
-			innerScaleFn := scoringfn
-			scoringfn = func(s wordle.Scoring) wordle.Strategy {
-				return innerScaleFn(&loggingScale{s, &log})
+		if excludePast && date == "" {
+			return fmt.Errorf("--exclude-past needs a --date")
+		}
+		if date != "" {
+			if *answersListOpt == "" {
+				return fmt.Errorf("--date needs the official --answers-list")
+			}
+			d, err := parseDate(date, time.Now())
+			if err != nil {
+				return err
 			}
+			dailyAnswer, err = wordle.DailyAnswer(answers, d)
+			if err != nil {
+				return err
+			}
+			if excludePast {
+				past := wordle.PastAnswers(answers, d)
+				used = append(used[:len(used):len(used)], past...)
+				log.Printf("%d answers used before %s", len(past), d.Format("2006-01-02"))
+			}
+			// The date's own answer is still to be played, even if
+			// --history already has it.
+			var kept []wordle.Word
+			for _, w := range used {
+				if w != dailyAnswer {
+					kept = append(kept, w)
+				}
+			}
+			used = kept
 		}
-
+		// Rule out the used answers once, so that every game, table
+		// and cache shares the same answers.
+		answers = wordle.NewGameWithAnswers(words, answers, used).Answers()
+		if *useMatchTableOpt {
+			table = wordle.NewMatchTable(words, answers)
+		}
+		if *seedOpt == 0 {
+			*seedOpt = time.Now().UnixNano()
+			fmt.Fprintf(os.Stderr, "Rolling the dice: --seed=%d\n", *seedOpt)
+		}
+		rng = rand.New(rand.NewSource(*seedOpt))
 		var wordFrequencies map[wordle.Word]float64
-		var mkStrategy = func(name string) (strategy wordle.Strategy, err error) {
-			switch strings.ToLower(name) {
-			case "common":
-				strategy = scoringfn(wordle.NewCommonLettersStrategy())
-			case "diversity":
-				strategy = scoringfn(wordle.NewUniqueLettersScoring())
-			case "freq":
+		env := &wordle.Env{
+			Words:   words,
+			Answers: answers,
+			Log:     &log,
+			Workers: *workersOpt,
+			WordFrequencies: func() (weights map[wordle.Word]float64, err error) {
 				if wordFrequencies == nil {
 					wordFrequencies, err = readWordFreqCSV(*wordFrequenciesOpt)
-					if err != nil {
-						return nil, err
+				}
+				return wordFrequencies, err
+			},
+			WrapStrategy: func(strategy wordle.Strategy) wordle.Strategy {
+				switch strategy.(type) {
+				case wordle.HailMary, wordle.Fixed:
+					// These just delegate to strategies that log.
+				default:
+					if *debugOpt {
+						strategy = &loggingStrategy{strategy, &log}
 					}
 				}
-				// 1 is the default score for unlisted words, if any
-				strategy = scoringfn(wordle.NewFreq(wordFrequencies, 1.0))
-			case "naive":
-				strategy = wordle.NaiveStrategy(rng)
-			case "selective":
-				strategy = scoringfn(wordle.NewSelectiveScale())
-			default:
-				return nil, fmt.Errorf("Unrecognized fallback strategy: %s", name)
-			}
-			if *debugOpt {
-				strategy = &loggingStrategy{strategy, &log}
-			}
-			return
+				return strategy
+			},
+			// Scorings are shared by the strategies of every game, so
+			// each is built, and its cache filled, only once.
+			WrapScoring: func(scoring wordle.Scoring) wordle.Scoring {
+				if *debugOpt {
+					// Wrap a logger around the scale function
+					scoring = &loggingScale{scoring, &log}
+				}
+				if *useCacheOpt {
+					scoring = wordle.NewScoringCache(scoring, answers)
+				}
+				return scoring
+			},
 		}
-		fallback, err := mkStrategy(*fallbackOpt)
-		if err != nil {
-			return err
+		strategyBuilder = &builder{env}
+		b := strategyBuilder
+		flags := strategyFlags{
+			strategy:   *strategyOpt,
+			fallback:   *fallbackOpt,
+			hailmary:   *hailmaryOpt,
+			score:      *scoreOpt,
+			exp:        *expOpt,
+			threshold:  *fallbackThresholdOpt,
+			objective:  *objectiveOpt,
+			candidates: *solverCandidatesOpt,
+			open:       *openOpt,
 		}
-
-		switch strings.ToLower(*strategyOpt) {
-		case "filtering":
-			strategy = wordle.NewFilteringStrategy(rng, &log, fallback, *fallbackThresholdOpt,
-				wordle.NewFreq(wordFrequencies, 1.0),
-			)
-			if *debugOpt {
-				strategy = &loggingStrategy{strategy, &log}
-			}
-		default:
-			strategy, err = mkStrategy(*strategyOpt)
-			if err != nil {
-				return err
+		newStrategyFor = func(name string) func(rng *rand.Rand) (wordle.Strategy, error) {
+			return func(rng *rand.Rand) (wordle.Strategy, error) {
+				c, err := flags.config(name)
+				if err != nil {
+					return nil, err
+				}
+				return b.strategy(c, rng)
 			}
 		}
-
-		if *hailmaryOpt != "" {
-			hailmary, err := mkStrategy(*hailmaryOpt)
+		newStrategy = newStrategyFor(*strategyOpt)
+		if *configOpt != "" {
+			for _, name := range []string{"strategy", "fallback", "hail-mary", "open", "score", "exp",
+				"fallback-threshold", "objective", "solver-candidates"} {
+				if cmd.Flags().Changed(name) {
+					return fmt.Errorf("--%s can't be used with --config", name)
+				}
+			}
+			c, err := readConfig(*configOpt)
 			if err != nil {
 				return err
 			}
-			strategy = wordle.NewHailMary(strategy, hailmary)
+			newStrategy = b.newStrategy(c)
 		}
-
-		var open []wordle.Word
-		for _, s := range *openOpt {
-			w, err := wordle.ParseWord(s)
+		newMultiStrategy = func(rng *rand.Rand) (wordle.MultiStrategy, error) {
+			strategy, err := newStrategy(rng)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			focus := wordle.NewFocusStrategy(strategy)
+			switch strings.ToLower(*multiStrategyOpt) {
+			case "focus":
+				return focus, nil
+			case "filtering":
+				tiebreaker, err := b.env.NewScoring("freq", nil)
+				if err != nil {
+					return nil, err
+				}
+				return wordle.NewMultiFilteringStrategy(rng, &log, focus, *fallbackThresholdOpt,
+					tiebreaker), nil
 			}
-			open = append(open, w)
+			return nil, fmt.Errorf("%s: unknown multi-board strategy", *multiStrategyOpt)
 		}
-		if len(open) > 0 {
-			strategy = wordle.FixedStrategy(open, strategy)
+		if boards > 1 && hard {
+			return fmt.Errorf("Hard mode isn't supported with more than one board")
 		}
-
-		return nil
+		strategy, err = newStrategy(rng)
+		return err
 	}
 
 	interactCmd := &cobra.Command{Use: "interact", Short: "Interactively guess a wordle answer."}
+	interactCmd.Flags().BoolVar(&hard, "hard", false,
+		"Play in hard mode: revealed hints must be used in later guesses.")
+	interactCmd.Flags().IntVar(&boards, "boards", 1,
+		"Number of boards played at once, as in Dordle (2) or Quordle (4).")
+	interactCmd.Flags().IntVar(&guessLimit, "guess-limit", 0,
+		"Guesses allowed with --boards, or 0 for the number of boards plus 5.")
+	explainOpt := interactCmd.Flags().Int("explain", 0,
+		"Show this many of the best candidates for each guess, with their scores.")
+	interactCmd.Flags().Lookup("explain").NoOptDefVal = "10"
 	interactCmd.RunE = func(cmd *cobra.Command, args []string) error {
-		game := wordle.NewGame(words, nil)
-		for !game.Over() {
-			guess := strategy.Guess(&game)
-			fmt.Println("My guess", guess)
-			var matchString string
-			for {
-				fmt.Print(`describe match (or "again" for a different guess): `)
-				fmt.Scanf("%s", &matchString)
-				// In case the chosen word is not allowed:
-				if strings.ToLower(matchString) == "again" {
-					game.RemoveWord(guess)
-					break
-				}
-				match, err := wordle.ParseMatch(matchString)
-				if err != nil {
-					fmt.Println(err)
-					continue
-				}
-				game = game.Guess(guess, match)
-				break
-			}
-		}
-		switch len(game.Guesses) {
-		case 1:
-			fmt.Println("Genius")
-		case 2:
-			fmt.Println("Magnificent")
-		case 3:
-			fmt.Println("Impressive")
-		case 4:
-			fmt.Println("Splendid")
-		case 5:
-			fmt.Println("Great")
-		case 6:
-			fmt.Println("Phew")
+		if boards > 1 {
+			if *explainOpt > 0 {
+				return fmt.Errorf("--explain doesn't support more than one board")
+			}
+			multiStrategy, err := newMultiStrategy(rng)
+			if err != nil {
+				return err
+			}
+			m := newMultiGame()
+			interactMulti(&m, multiStrategy)
+			return nil
 		}
+		game := newGame()
+		newInteractor(os.Stdin, os.Stdout, strategy, words, *explainOpt).play(&game)
 		return nil
 	}
 
 	playCmd := &cobra.Command{Use: "play", Short: "Play automatically with the given answer."}
 	repeatOpt := playCmd.Flags().IntP("repeat", "n", 0, "Play multiple games per answer.")
 	answersOpt := playCmd.Flags().StringP("answers", "a", "", "Load answers from a file.")
+	jobsOpt := playCmd.Flags().IntP("jobs", "j", runtime.NumCPU(),
+		"Games played concurrently with --repeat.")
+	playCmd.Flags().BoolVar(&hard, "hard", false,
+		"Play in hard mode: revealed hints must be used in later guesses.")
+	adversarialOpt := playCmd.Flags().Bool("adversarial", false,
+		"Play against a host that avoids committing to any answer.")
+	outputOpt := playCmd.Flags().StringP("output", "O", "",
+		"Write a record of each game instead of a summary. One of: json, csv")
+	playCmd.Flags().IntVar(&boards, "boards", 1,
+		"Number of boards played at once, as in Dordle (2) or Quordle (4). "+
+			"Each consecutive group of answers is one game.")
+	playCmd.Flags().IntVar(&guessLimit, "guess-limit", 0,
+		"Guesses allowed with --boards, or 0 for the number of boards plus 5.")
+	playCmd.Flags().StringVar(&date, "date", "",
+		"Also play the official answer of this date: 2022-01-31, today or yesterday.")
+	playCmd.Flags().BoolVar(&excludePast, "exclude-past", false,
+		"Answers used before --date can't be the answer.")
 	playCmd.RunE = func(cmd *cobra.Command, args []string) error {
-		answers := make([]wordle.Word, len(args))
+		if *outputOpt != "" {
+			if err := checkFormat(*outputOpt); err != nil {
+				return err
+			}
+		}
+		targets := make([]wordle.Word, len(args))
 		for idx, s := range args {
 			answer, err := wordle.ParseWord(s)
 			if err != nil {
 				return fmt.Errorf("%s: %w", s, err)
 			}
-			answers[idx] = answer
+			targets[idx] = answer
 		}
 		if *answersOpt != "" {
 			loaded, err := readWordFile(*answersOpt, func(word string, lineno int, err error) error {
@@ -258,17 +386,68 @@ func main() {
 			if err != nil {
 				return err
 			}
-			answers = append(answers, loaded...)
+			targets = append(targets, loaded...)
+		}
+		if date != "" {
+			targets = append(targets, dailyAnswer)
+		}
+		newHost := func(answer wordle.Word) wordle.Host {
+			return wordle.NewAnswerHost(answer)
+		}
+		if boards > 1 && *adversarialOpt {
+			return fmt.Errorf("The adversarial host doesn't support more than one board")
+		}
+		if *adversarialOpt {
+			if len(targets) > 0 {
+				return fmt.Errorf("The adversarial host doesn't take answers")
+			}
+			// The adversary has no answer, so play a single,
+			// unnamed game (per repetition).
+			targets = []wordle.Word{{}}
+			newHost = func(wordle.Word) wordle.Host {
+				return wordle.NewAdversarialHost()
+			}
+		} else {
+			if err := checkAnswers(targets, newGame().Answers()); err != nil {
+				return err
+			}
+		}
+		if boards > 1 {
+			if *outputOpt != "" {
+				return fmt.Errorf("--output doesn't support more than one board")
+			}
+			if len(targets)%boards != 0 {
+				return fmt.Errorf("%d answers can't be split across %d boards", len(targets), boards)
+			}
+			var groups [][]wordle.Word
+			for idx := 0; idx < len(targets); idx += boards {
+				groups = append(groups, targets[idx:idx+boards])
+			}
+			return playMultiGames(groups, *repeatOpt, *jobsOpt, *seedOpt, newMultiGame, newMultiStrategy)
 		}
 		if *repeatOpt == 0 {
-			for _, answer := range answers {
-				game := wordle.NewGame(words, nil)
-				play(&game, strategy, answer)
+			var results []gameResult
+			for _, answer := range targets {
+				game := newGame()
+				if err := play(&game, strategy, newHost(answer)); err != nil {
+					return err
+				}
+				if *outputOpt != "" {
+					results = append(results, gameResult{answer: answer, seed: *seedOpt, game: game})
+					continue
+				}
 				fmt.Println(game)
-				if !game.Won() {
+				switch {
+				case game.Won():
+				case *adversarialOpt:
+					fmt.Println("Possible answers remaining:", len(game.PossibleAnswers()))
+				default:
 					fmt.Println("The answer was:", answer)
 				}
 			}
+			if *outputOpt != "" {
+				return writeResults(os.Stdout, *outputOpt, results)
+			}
 		} else if *repeatOpt > 0 {
 			if *cpuProfileOpt != "" {
 				f, err := os.Create(*cpuProfileOpt)
@@ -279,31 +458,17 @@ func main() {
 				pprof.StartCPUProfile(f)
 				defer pprof.StopCPUProfile()
 			}
-			var guesses int
-			var minGuesses int = 7
-			var maxGuesses int = 0
-			var wins int = 0
-			for i := 0; i < *repeatOpt; i++ {
-				for _, answer := range answers {
-					log.Debug().Stringer("answer", answer).Msg("New Game")
-					game := wordle.NewGame(words, nil)
-					play(&game, strategy, answer)
-					if game.Won() {
-						wins += 1
-					}
-					guesses += len(game.Guesses)
-					if len(game.Guesses) > maxGuesses {
-						maxGuesses = len(game.Guesses)
-					}
-					if len(game.Guesses) < minGuesses {
-						minGuesses = len(game.Guesses)
-					}
+			results, err := playAll(targets, *repeatOpt, *jobsOpt, *seedOpt, newGame, newStrategy, newHost)
+			if err != nil {
+				return err
+			}
+			if *outputOpt != "" {
+				if err := writeResults(os.Stdout, *outputOpt, results); err != nil {
+					return err
 				}
+			} else {
+				printSummary(os.Stdout, results)
 			}
-			games := *repeatOpt * len(answers)
-			fmt.Printf("Won %d of %d games (%0.1f%%). Guesses: avg %0.1f, min %d, max %d\n",
-				wins, games, float64(wins)/float64(games)*100, float64(guesses)/float64(games),
-				minGuesses, maxGuesses)
 			if *memProfileOpt != "" {
 				f, err := os.Create(*memProfileOpt)
 				if err != nil {
@@ -318,7 +483,230 @@ func main() {
 		}
 		return nil
 	}
-	root.AddCommand(interactCmd, playCmd)
+	compareCmd := &cobra.Command{
+		Use:   "compare strategy strategy...",
+		Short: "Compare strategies over the same games.",
+		Long: ("Play every answer with each strategy, using the same seed for " +
+			"each game across strategies, and compare the results. Each " +
+			"strategy is a --strategy value, with the other options shared, " +
+			"or a --config file ending in .json."),
+		Args: cobra.MinimumNArgs(2),
+	}
+	compareAnswersOpt := compareCmd.Flags().StringP("answers", "a", "",
+		"Load answers from a file, rather than playing every possible answer.")
+	compareRepeatOpt := compareCmd.Flags().IntP("repeat", "n", 1, "Games played per answer.")
+	compareJobsOpt := compareCmd.Flags().IntP("jobs", "j", runtime.NumCPU(),
+		"Games played concurrently.")
+	compareCmd.Flags().BoolVar(&hard, "hard", false,
+		"Play in hard mode: revealed hints must be used in later guesses.")
+	compareCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		targets := newGame().Answers()
+		if *compareAnswersOpt != "" {
+			var err error
+			targets, err = readWordFile(*compareAnswersOpt, func(word string, lineno int, err error) error {
+				log.Printf("%s:%d: %s: %v\n", *compareAnswersOpt, lineno, word, err)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if err := checkAnswers(targets, newGame().Answers()); err != nil {
+				return err
+			}
+		}
+		newHost := func(answer wordle.Word) wordle.Host {
+			return wordle.NewAnswerHost(answer)
+		}
+		var results = make([][]gameResult, len(args))
+		for idx, name := range args {
+			var err error
+			newStrategy := newStrategyFor(name)
+			if strings.HasSuffix(name, ".json") {
+				c, err := readConfig(name)
+				if err != nil {
+					return err
+				}
+				newStrategy = strategyBuilder.newStrategy(c)
+			}
+			results[idx], err = playAll(targets, *compareRepeatOpt, *compareJobsOpt, *seedOpt,
+				newGame, newStrategy, newHost)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		printComparison(os.Stdout, args, results)
+		return nil
+	}
+
+	solveCmd := &cobra.Command{
+		Use:   "solve word:match...",
+		Short: "Suggest the next guess given the guesses so far.",
+		Long: ("Suggest the next guess given the guesses so far, each described as " +
+			`a word and its match, for example: solve crane:..y.g slate:g.y..`),
+	}
+	solveCmd.Flags().StringVar(&date, "date", "",
+		"Date of the official puzzle being solved, for --exclude-past: 2022-01-31, today or yesterday.")
+	solveCmd.Flags().BoolVar(&excludePast, "exclude-past", false,
+		"Answers used before --date can't be the answer.")
+	solveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		game := newGame()
+		known := knownWords(words)
+		for _, arg := range args {
+			g, err := wordle.ParseGuess(arg)
+			if err != nil {
+				return fmt.Errorf("%s: %w", arg, err)
+			}
+			if !known[g.Word] {
+				return fmt.Errorf("%s: not in the word list", g.Word)
+			}
+			game = game.Guess(g.Word, g.Match)
+		}
+		if game.Won() {
+			fmt.Println("Solved:", game.Guesses[len(game.Guesses)-1].Word)
+			return nil
+		}
+		possible := game.PossibleAnswers()
+		if len(possible) == 0 {
+			return fmt.Errorf("No possible answers, check the matches")
+		}
+		fmt.Printf("%d possible answers:", len(possible))
+		for idx, w := range possible {
+			if idx%10 == 0 {
+				fmt.Print("\n ")
+			}
+			fmt.Print(" ", w)
+		}
+		fmt.Println()
+		if game.Over() {
+			fmt.Println("No guesses remaining")
+			return nil
+		}
+		stats := game.Stats(strategy.Guess(&game))
+		fmt.Printf("Next guess: %s (expected remaining %0.2f, worst case %d, entropy %0.2f bits)\n",
+			stats.Word, stats.ExpectedRemaining, stats.WorstCase, stats.Entropy)
+		return nil
+	}
+
+	hostCmd := &cobra.Command{
+		Use:   "host",
+		Short: "Pick an answer for you to guess.",
+		Long: ("Pick an answer for you to guess, as the official game does, " +
+			"using the same word and answer lists as the strategies."),
+		Args: cobra.NoArgs,
+	}
+	hostAnswerOpt := hostCmd.Flags().String("answer", "", "The answer, rather than one picked at random.")
+	hostCmd.Flags().BoolVar(&hard, "hard", false,
+		"Play in hard mode: revealed hints must be used in later guesses.")
+	noColorOpt := hostCmd.Flags().Bool("no-color", false, "Show matches as text rather than in color.")
+	hostCmd.Flags().StringVar(&date, "date", "",
+		"Host the official answer of this date: 2022-01-31, today or yesterday.")
+	hostCmd.Flags().BoolVar(&excludePast, "exclude-past", false,
+		"Answers used before --date can't be the answer.")
+	hostCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var answer wordle.Word
+		switch {
+		case date != "" && *hostAnswerOpt != "":
+			return fmt.Errorf("--answer and --date both choose the answer")
+		case date != "":
+			answer = dailyAnswer
+		case *hostAnswerOpt != "":
+			var err error
+			answer, err = wordle.ParseWord(strings.ToLower(*hostAnswerOpt))
+			if err != nil {
+				return fmt.Errorf("%s: %w", *hostAnswerOpt, err)
+			}
+			if !knownWords(words)[answer] {
+				return fmt.Errorf("%s: not in the word list", answer)
+			}
+		default:
+			unused := newGame().Answers()
+			if len(unused) == 0 {
+				return fmt.Errorf("no unused answers left")
+			}
+			answer = unused[rng.Intn(len(unused))]
+		}
+		color := !*noColorOpt && os.Getenv("NO_COLOR") == "" && isTerminal(os.Stdout)
+		game := newGame()
+		newHoster(os.Stdin, os.Stdout, words, color).play(&game, answer)
+		return nil
+	}
+
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the solver over HTTP.",
+		Long: ("Serve the solver over HTTP. POST a JSON object like " +
+			`{"guesses": ["crane:..y.g"], "hard": false} to /solve to get the ` +
+			"possible answers, the recommended guess and candidate scores."),
+	}
+	addrOpt := serveCmd.Flags().String("addr", "localhost:8080", "Address to listen on.")
+	candidatesOpt := serveCmd.Flags().Int("candidates", 10,
+		"Candidate guesses to score in each response, or 0 for all.")
+	timeoutOpt := serveCmd.Flags().Duration("timeout", 2*time.Second,
+		"Time to search for a guess before answering with the best so far, or 0 for no limit.")
+	serveCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		mux := http.NewServeMux()
+		mux.Handle("/solve", newSolveHandler(words, newGame, newStrategy, *seedOpt, *candidatesOpt, *timeoutOpt))
+		log.Info().Str("addr", *addrOpt).Msg("Serving")
+		return http.ListenAndServe(*addrOpt, mux)
+	}
+
+	searchCmd := &cobra.Command{
+		Use:   "search pattern...",
+		Short: "List the words matching a pattern.",
+		Long: ("List the words matching a pattern, most common first. " +
+			"For example, search s?a?e +r -tio finds words with S, A and E " +
+			"at those positions, containing R, and without T, I or O. " +
+			"Repeat a letter, as in +ee, to require it more than once. " +
+			"Options must come before the pattern, and a pattern that starts " +
+			"with excluded letters must follow --, as in search -- -tio s?a?e."),
+		Args: cobra.MinimumNArgs(1),
+	}
+	searchLimitOpt := searchCmd.Flags().IntP("limit", "n", 0, "List at most this many words, or 0 for all.")
+	// Options come before the pattern, so that excluded letters
+	// aren't taken for options. Those that start the pattern still
+	// are, unless after --.
+	searchCmd.Flags().SetInterspersed(false)
+	searchCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return fmt.Errorf("%w (put -- before a pattern that starts with excluded letters)", err)
+	})
+	searchCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		freq, err := strategyBuilder.env.NewScoring("freq", nil)
+		if err != nil {
+			return err
+		}
+		found, err := search(words, strings.Join(args, " "), freq)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d words:", len(found))
+		if *searchLimitOpt > 0 && len(found) > *searchLimitOpt {
+			found = found[:*searchLimitOpt]
+		}
+		for idx, w := range found {
+			if idx%10 == 0 {
+				fmt.Print("\n ")
+			}
+			fmt.Print(" ", w)
+		}
+		fmt.Println()
+		return nil
+	}
+
+	strategiesCmd := &cobra.Command{
+		Use:   "strategies",
+		Short: "List the strategies and scorings, with their parameters.",
+		Long: ("List the strategies and scorings, with the parameters a " +
+			"--config file can give them."),
+		Args: cobra.NoArgs,
+		// Needs none of the words or strategies loaded for the
+		// other commands.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+		Run: func(cmd *cobra.Command, args []string) {
+			printRegistry(os.Stdout)
+		},
+	}
+
+	root.AddCommand(interactCmd, playCmd, compareCmd, solveCmd, searchCmd, hostCmd, serveCmd, strategiesCmd)
 	root.Execute()
 }
 
@@ -355,8 +743,20 @@ type loggingStrategy struct {
 }
 
 func (s *loggingStrategy) Guess(game *wordle.Game) wordle.Word {
+	return s.GuessContext(context.Background(), game)
+}
+
+func (s *loggingStrategy) Explain(game *wordle.Game, n int) (wordle.Word, []wordle.Candidate) {
+	return wordle.Explain(s.inner, game, n)
+}
+
+func (s *loggingStrategy) ExplainContext(ctx context.Context, game *wordle.Game, n int) (wordle.Word, []wordle.Candidate) {
+	return wordle.ExplainContext(ctx, s.inner, game, n)
+}
+
+func (s *loggingStrategy) GuessContext(ctx context.Context, game *wordle.Game) wordle.Word {
 	inner := reflect.TypeOf(s.inner)
-	w := s.inner.Guess(game)
+	w := wordle.GuessContext(ctx, s.inner, game)
 	s.log.
 		Printf("%s chose %q for guess %d, %d possible answers remaining",
 			inner.Name(), w, len(game.Guesses)+1, len(game.PossibleAnswers()))
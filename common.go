@@ -35,3 +35,13 @@ func (x CommonLettersStrategy) Weights(words []Word) []float64 {
 	}
 	return scores
 }
+
+func init() {
+	RegisterScoring(ScoringDef{
+		Name:        "common",
+		Description: "Score words by how common their letters are",
+		New: func(env *Env, args Args) (Scoring, error) {
+			return NewCommonLettersStrategy(), nil
+		},
+	})
+}
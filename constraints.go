@@ -0,0 +1,175 @@
+package wordle
+
+import (
+	"math/bits"
+)
+
+// Constraints is what we know about an answer from one or more
+// Guesses: the letter known to be at each position, the letters known
+// not to be at each position, and the minimum and maximum number of
+// times each letter appears.
+//
+// A word is allowed by the Constraints built from a Guess exactly
+// when playing the Guess's word against it would produce the Guess's
+// Match.
+type Constraints struct {
+	// Letter at each position, or 0 if unknown.
+	exact [WordLen]byte
+	// Letters that cannot be at each position.
+	excluded [WordLen]Letters
+	// Minimum and maximum count of each letter.
+	min LetterCounts
+	max LetterCounts
+	// Letters with a non-trivial min or max count.
+	counted Letters
+}
+
+// NewConstraints returns Constraints that allow every word.
+func NewConstraints() Constraints {
+	var c Constraints
+	for idx := range c.max {
+		c.max[idx] = WordLen
+	}
+	return c
+}
+
+// Constraints derives what the Guess tells us about the answer.
+//
+// Green squares fix the letter at that position. Yellow and grey
+// squares exclude the letter from that position, since otherwise the
+// square would have been green. Each colored square counts towards
+// the minimum number of times a letter appears in the answer, and a
+// grey square for a letter also caps it at that minimum: the answer
+// has no more instances than were colored.
+func (g Guess) Constraints() Constraints {
+	var c = NewConstraints()
+	var colored, grey LetterCounts
+	for idx, l := range g.Word {
+		switch {
+		case maskSet(g.Match.exact, idx):
+			c.exact[idx] = l
+			colored.Add(l)
+		case g.Match.Used(idx):
+			c.excluded[idx] = c.excluded[idx].AddChar(l)
+			colored.Add(l)
+		default:
+			c.excluded[idx] = c.excluded[idx].AddChar(l)
+			grey.Add(l)
+		}
+	}
+	for _, l := range g.Word {
+		c.SetMin(l, colored[l-'a'])
+		if grey[l-'a'] > 0 {
+			c.SetMax(l, colored[l-'a'])
+		}
+	}
+	return c
+}
+
+// SetExact requires the letter at position idx to be l.
+func (c *Constraints) SetExact(idx int, l byte) {
+	c.exact[idx] = l
+}
+
+// Exclude forbids letter l at position idx.
+func (c *Constraints) Exclude(idx int, l byte) {
+	c.excluded[idx] = c.excluded[idx].AddChar(l)
+}
+
+// SetMin requires at least n instances of letter l, if that is more
+// than is already required.
+func (c *Constraints) SetMin(l byte, n byte) {
+	if n > c.min[l-'a'] {
+		c.min[l-'a'] = n
+		c.counted = c.counted.AddChar(l)
+	}
+}
+
+// SetMax allows at most n instances of letter l, if that is fewer
+// than is already allowed.
+func (c *Constraints) SetMax(l byte, n byte) {
+	if n < c.max[l-'a'] {
+		c.max[l-'a'] = n
+		c.counted = c.counted.AddChar(l)
+	}
+}
+
+// Min returns the minimum number of times letter l appears.
+func (c Constraints) Min(l byte) byte {
+	return c.min[l-'a']
+}
+
+// Max returns the maximum number of times letter l appears.
+func (c Constraints) Max(l byte) byte {
+	return c.max[l-'a']
+}
+
+// Exact returns the letter known to be at position idx, or 0.
+func (c Constraints) Exact(idx int) byte {
+	return c.exact[idx]
+}
+
+// Excluded returns the letters known not to be at position idx.
+func (c Constraints) Excluded(idx int) Letters {
+	return c.excluded[idx]
+}
+
+// Merge combines what is known from both Constraints.
+func (c Constraints) Merge(o Constraints) Constraints {
+	for idx := 0; idx < WordLen; idx++ {
+		if o.exact[idx] != 0 {
+			c.exact[idx] = o.exact[idx]
+		}
+		c.excluded[idx] = c.excluded[idx].Add(o.excluded[idx])
+	}
+	for l := byte('a'); l <= 'z'; l++ {
+		c.SetMin(l, o.min[l-'a'])
+		c.SetMax(l, o.max[l-'a'])
+	}
+	return c
+}
+
+// Revealed returns just the part of the Constraints that hard mode
+// requires later guesses to reuse: the letter known at each position
+// and the minimum count of each letter.
+func (c Constraints) Revealed() Constraints {
+	var r = NewConstraints()
+	r.exact = c.exact
+	for l := byte('a'); l <= 'z'; l++ {
+		r.SetMin(l, c.min[l-'a'])
+	}
+	return r
+}
+
+// Allows returns true if the given word satisfies the Constraints.
+func (c Constraints) Allows(w Word) bool {
+	for idx, l := range w {
+		if c.exact[idx] != 0 && c.exact[idx] != l {
+			return false
+		}
+		if c.excluded[idx].Contains(l) {
+			return false
+		}
+	}
+	if c.counted.Empty() {
+		return true
+	}
+	var lc = w.LetterCounts()
+	for rest := uint32(c.counted); rest != 0; rest &= rest - 1 {
+		idx := bits.TrailingZeros32(rest)
+		if lc[idx] < c.min[idx] || lc[idx] > c.max[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the words allowed by the Constraints.
+func (c Constraints) Filter(words []Word) (allowed []Word) {
+	for _, w := range words {
+		if c.Allows(w) {
+			allowed = append(allowed, w)
+		}
+	}
+	return
+}
@@ -0,0 +1,71 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuessConstraints(t *testing.T) {
+	c := Guess{mkw("geese"), mkm(".yy..")}.Constraints()
+	assert.Equal(t, byte(2), c.Min('e'))
+	assert.Equal(t, byte(2), c.Max('e'))
+	assert.Equal(t, byte(0), c.Max('g'))
+	assert.Equal(t, byte(0), c.Max('s'))
+	assert.True(t, c.Excluded(1).Contains('e'))
+	assert.True(t, c.Allows(mkw("embed")))
+	assert.True(t, c.Allows(mkw("elder")))
+	assert.False(t, c.Allows(mkw("emcee")))
+	assert.False(t, c.Allows(mkw("eerie")))
+
+	c = Guess{mkw("llama"), mkm("Gy...")}.Constraints()
+	assert.Equal(t, byte('l'), c.Exact(0))
+	assert.Equal(t, byte(2), c.Min('l'))
+	assert.Equal(t, byte(5), c.Max('l'))
+	assert.True(t, c.Allows(mkw("lolly")))
+	assert.False(t, c.Allows(mkw("label")))
+}
+
+func TestConstraintsMerge(t *testing.T) {
+	c := Guess{mkw("crane"), mkm("..y.g")}.Constraints()
+	c = c.Merge(Guess{mkw("abide"), mkm("g...g")}.Constraints())
+	assert.Equal(t, byte('a'), c.Exact(0))
+	assert.Equal(t, byte('e'), c.Exact(4))
+	assert.Equal(t, byte(0), c.Max('r'))
+	assert.Equal(t, byte(0), c.Max('b'))
+	assert.True(t, c.Allows(mkw("amuse")))
+	assert.False(t, c.Allows(mkw("abate")))
+}
+
+// FilterPossible must agree with Word.Match for every guess and answer.
+func TestConstraintsAgreeWithMatch(t *testing.T) {
+	guesses := []Word{
+		mkw("crane"), mkw("geese"), mkw("eerie"), mkw("llama"),
+		mkw("mamma"), mkw("sissy"), mkw("tilde"), mkw("array"),
+	}
+	for i := 0; i < len(globalWords); i += 97 {
+		answer := globalWords[i]
+		for _, guess := range guesses {
+			match := guess.Match(answer)
+			var expect []Word
+			for _, w := range globalWords {
+				if guess.Match(w) == match {
+					expect = append(expect, w)
+				}
+			}
+			got := Guess{guess, match}.FilterPossible(globalWords)
+			assert.Equal(t, expect, got, "%s against %s (%s)", guess, answer, match)
+		}
+	}
+}
+
+func TestConstraintsRevealed(t *testing.T) {
+	c := Guess{mkw("geese"), mkm("Gy...")}.Constraints().Revealed()
+	assert.Equal(t, byte('g'), c.Exact(0))
+	assert.Equal(t, byte(1), c.Min('e'))
+	assert.Equal(t, byte(5), c.Max('e'))
+	assert.Equal(t, byte(5), c.Max('s'))
+	assert.True(t, c.Excluded(1).Empty())
+	assert.True(t, c.Allows(mkw("gecko")))
+	assert.False(t, c.Allows(mkw("gawky")))
+}
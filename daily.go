@@ -0,0 +1,42 @@
+package wordle
+
+import (
+	"fmt"
+	"time"
+)
+
+// FirstDay is the date of the official game's first answer.
+var FirstDay = time.Date(2021, time.June, 19, 0, 0, 0, 0, time.UTC)
+
+// DayNumber returns the number of days from FirstDay to the given
+// calendar date, in the date's own location.
+func DayNumber(date time.Time) int {
+	var day = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(FirstDay).Hours() / 24)
+}
+
+// DailyAnswer returns the answer of the official game on the given
+// date, from answers listed in the order they were used.
+func DailyAnswer(answers []Word, date time.Time) (Word, error) {
+	var n = DayNumber(date)
+	if n < 0 || n >= len(answers) {
+		return Word{}, fmt.Errorf("No answer for %s: answers run from %s to %s",
+			date.Format("2006-01-02"), FirstDay.Format("2006-01-02"),
+			FirstDay.AddDate(0, 0, len(answers)-1).Format("2006-01-02"))
+	}
+	return answers[n], nil
+}
+
+// PastAnswers returns the answers used before the given date, from
+// answers listed in the order they were used. The answer of the date
+// itself isn't included, so that day's game can still be played.
+func PastAnswers(answers []Word, date time.Time) []Word {
+	var n = DayNumber(date)
+	switch {
+	case n < 0:
+		return nil
+	case n > len(answers):
+		return answers
+	}
+	return answers[:n]
+}
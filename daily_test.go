@@ -0,0 +1,36 @@
+package wordle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailyAnswer(t *testing.T) {
+	assert.Equal(t, 0, DayNumber(FirstDay))
+	// Late in the day, far from UTC, is still the same date.
+	late := time.Date(2021, time.June, 20, 23, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+	assert.Equal(t, 1, DayNumber(late))
+	assert.Equal(t, 365, DayNumber(time.Date(2022, time.June, 19, 0, 0, 0, 0, time.Local)))
+
+	answer, err := DailyAnswer(globalAnswers, FirstDay)
+	assert.Nil(t, err)
+	assert.Equal(t, mkw("cigar"), answer)
+	answer, err = DailyAnswer(globalAnswers, late)
+	assert.Nil(t, err)
+	assert.Equal(t, mkw("rebut"), answer)
+
+	_, err = DailyAnswer(globalAnswers, FirstDay.AddDate(0, 0, -1))
+	assert.EqualError(t, err, "No answer for 2021-06-18: answers run from 2021-06-19 to 2027-10-20")
+	_, err = DailyAnswer(globalAnswers, FirstDay.AddDate(0, 0, len(globalAnswers)))
+	assert.NotNil(t, err)
+}
+
+func TestPastAnswers(t *testing.T) {
+	assert.Empty(t, PastAnswers(globalAnswers, FirstDay.AddDate(0, 0, -1)))
+	assert.Empty(t, PastAnswers(globalAnswers, FirstDay))
+	assert.Equal(t, []Word{mkw("cigar"), mkw("rebut")},
+		PastAnswers(globalAnswers, FirstDay.AddDate(0, 0, 2)))
+	assert.Equal(t, globalAnswers, PastAnswers(globalAnswers, FirstDay.AddDate(0, 0, len(globalAnswers)+1)))
+}
@@ -16,3 +16,13 @@ func (n UniqueLettersScoring) Weights(words []Word) []float64 {
 	}
 	return scores
 }
+
+func init() {
+	RegisterScoring(ScoringDef{
+		Name:        "diversity",
+		Description: "Score words by their number of distinct letters",
+		New: func(env *Env, args Args) (Scoring, error) {
+			return NewUniqueLettersScoring(), nil
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package wordle
+
+import (
+	"context"
+	"math"
+	"math/rand"
+)
+
+type EntropyStrategy struct {
+	rng *rand.Rand
+	log Logger
+	// Fallback strategy when there are too many choices
+	fallback Strategy
+	// Use the fallback strategy when this many words remain
+	threshold int
+	// If several words are equally informative, use this scoring to
+	// weight them and then choose randomly.
+	tiebreaker Scoring
+}
+
+// Select the word that maximizes the expected information gained
+// about the answer: the Shannon entropy of the partition of the
+// possible answers by the Match each would produce.
+//
+// Like FilteringStrategy, candidates include a random sample of words
+// that can't be the answer, and a fallback strategy is used while
+// there are more than threshold possible answers.
+func NewEntropyStrategy(rng *rand.Rand, log Logger, fallback Strategy, threshold int, tiebreaker Scoring) *EntropyStrategy {
+	return &EntropyStrategy{rng, log, fallback, threshold, tiebreaker}
+}
+
+func (n EntropyStrategy) Guess(game *Game) Word {
+	return n.GuessContext(context.Background(), game)
+}
+
+// GuessContext is Guess, but when ctx is done it stops evaluating
+// candidates and chooses among those evaluated so far. If there were
+// none, it asks the fallback strategy.
+func (n EntropyStrategy) GuessContext(ctx context.Context, game *Game) Word {
+	guess, _ := n.guess(ctx, game, 0)
+	return guess
+}
+
+// Explain scores candidates by their entropy, in bits.
+func (n EntropyStrategy) Explain(game *Game, top int) (Word, []Candidate) {
+	return n.guess(context.Background(), game, top)
+}
+
+// ExplainContext is Explain, but only of the candidates evaluated
+// before ctx is done.
+func (n EntropyStrategy) ExplainContext(ctx context.Context, game *Game, top int) (Word, []Candidate) {
+	return n.guess(ctx, game, top)
+}
+
+// guess implements GuessContext, and ExplainContext when top > 0.
+func (n EntropyStrategy) guess(ctx context.Context, game *Game, top int) (Word, []Candidate) {
+	var possible = game.PossibleAnswers()
+	if len(possible) > n.threshold || len(possible) == 0 {
+		return explainFallback(ctx, n.fallback, game, top)
+	}
+	if len(possible) == 1 {
+		return explainOnly(game, possible[0], 0, top)
+	}
+
+	var candidates = sample(n.rng, game.Playable(game.words), n.threshold)
+	candidates = append(candidates, possible...)
+
+	var choices []Word
+	var scores []float64
+	var choiceEntropy = -1.0
+	var buckets matchBuckets
+	var index = game.table.Index(possible)
+	var codes = make([]uint8, len(possible))
+	for _, candidate := range candidates {
+		if done(ctx) {
+			break
+		}
+		game.table.Codes(candidate, possible, index, codes)
+		buckets.count(codes)
+		var entropy = buckets.entropy(len(possible))
+		scores = append(scores, entropy)
+		if entropy > choiceEntropy {
+			choices = choices[:0] // truncate
+			choices = append(choices, candidate)
+			choiceEntropy = entropy
+		} else if entropy == choiceEntropy {
+			choices = append(choices, candidate)
+		}
+	}
+	if len(choices) == 0 {
+		n.log.Printf("out of time before evaluating any candidates\n")
+		return explainFallback(ctx, n.fallback, game, top)
+	}
+	var idx int
+	if len(choices) > 1 {
+		weights := n.tiebreaker.Weights(choices)
+		idx = weightedSample(n.rng, 2.0, weights)
+	}
+	choice := choices[idx]
+	n.log.Printf("%s has entropy %f bits, chosen from %d choices\n",
+		choice, choiceEntropy, len(choices))
+	if top <= 0 {
+		return choice, nil
+	}
+	return choice, rankCandidates(game, choice, candidates, scores,
+		func(a, b float64) bool { return a > b }, top)
+}
+
+// matchBuckets counts words by the Match they produce against a
+// guess, indexed by Match.Code.
+type matchBuckets [MatchCodes]int
+
+// partition counts each of the answers by the Match it would produce
+// for the given guess.
+func (b *matchBuckets) partition(guess Word, answers []Word) {
+	*b = matchBuckets{}
+	for _, answer := range answers {
+		b[guess.Match(answer).Code()]++
+	}
+}
+
+// count counts precomputed Match codes.
+func (b *matchBuckets) count(codes []uint8) {
+	*b = matchBuckets{}
+	for _, code := range codes {
+		b[code]++
+	}
+}
+
+// entropy returns the Shannon entropy, in bits, of the partition of n
+// words.
+func (b *matchBuckets) entropy(n int) float64 {
+	var sum float64
+	for _, count := range b {
+		if count > 1 {
+			sum += float64(count) * math.Log2(float64(count))
+		}
+	}
+	return math.Log2(float64(n)) - sum/float64(n)
+}
+
+// largest returns the size of the largest bucket.
+func (b *matchBuckets) largest() (n int) {
+	for _, count := range b {
+		if count > n {
+			n = count
+		}
+	}
+	return
+}
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "entropy",
+		Description: "Guess the word whose match tells the most about the answer",
+		Params: []Param{
+			{Name: "fallback", Kind: StrategyParam, Description: "Strategy used when too many answers remain", Default: "weighted"},
+			{Name: "threshold", Kind: IntParam, Description: "Possible answers above which the fallback is used", Default: 150},
+			{Name: "tiebreaker", Kind: ScoringParam, Description: "Scoring to choose among equally good guesses", Default: "freq"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NewEntropyStrategy(rng, env.Log, args.Strategy("fallback"),
+				args.Int("threshold"), args.Scoring("tiebreaker")), nil
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package wordle
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntropyPlay(t *testing.T) {
+	rng := mkRand(1)
+	fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
+	strategy := NewEntropyStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring())
+	game := NewGame(globalWords, nil)
+	answer, err := ParseWord("cigar")
+	assert.Nil(t, err)
+	for !game.Over() {
+		guess := strategy.Guess(&game)
+		match := guess.Match(answer)
+		game = game.Guess(guess, match)
+	}
+	assert.True(t, game.Won())
+	assert.Len(t, game.Guesses, 5) // arbitrary, but detect if something changes
+}
+
+func TestMatchBucketsEntropy(t *testing.T) {
+	var b matchBuckets
+	possible := []Word{mkw("areas"), mkw("arias"), mkw("arnas"), mkw("arpas"), mkw("arras")}
+	// "ferny" splits the five answers into buckets of 1, 1, 1 and 2.
+	b.partition(mkw("ferny"), possible)
+	assert.InDelta(t, math.Log2(5)-2.0/5, b.entropy(len(possible)), 1e-9)
+	// "zzzzz" shares no letters with any answer, so every answer lands
+	// in the same bucket and the guess reveals nothing.
+	b.partition(mkw("zzzzz"), possible)
+	assert.Equal(t, 0.0, b.entropy(len(possible)))
+}
@@ -0,0 +1,102 @@
+package wordle
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// Candidate is a guess a strategy considered, with its GuessStats.
+type Candidate struct {
+	GuessStats
+	// The strategy's own measure of the guess, which it ranks
+	// candidates by: expected remaining answers for filtering, entropy
+	// for entropy, and worst case for minimax.
+	Score float64
+}
+
+// Explainer is a Strategy that can show its work.
+type Explainer interface {
+	Strategy
+	// Explain returns the strategy's next guess, just as Guess would,
+	// along with up to n of the candidates it chose among, best first.
+	// The guess comes first if it was among the best.
+	Explain(game *Game, n int) (Word, []Candidate)
+}
+
+// ContextExplainer is an Explainer that can be cut short, as a
+// ContextStrategy can. The candidates are those it evaluated in time.
+type ContextExplainer interface {
+	Explainer
+	ExplainContext(ctx context.Context, game *Game, n int) (Word, []Candidate)
+}
+
+// Explain asks strategy for its next guess and, if strategy is an
+// Explainer, up to n of the candidates it chose among. Otherwise the
+// only candidate is the guess itself.
+func Explain(strategy Strategy, game *Game, n int) (Word, []Candidate) {
+	return ExplainContext(context.Background(), strategy, game, n)
+}
+
+// ExplainContext is Explain, cutting the search short when ctx is done
+// if strategy is a ContextExplainer, or a ContextStrategy that doesn't
+// explain itself.
+func ExplainContext(ctx context.Context, strategy Strategy, game *Game, n int) (Word, []Candidate) {
+	if e, ok := strategy.(ContextExplainer); ok {
+		return e.ExplainContext(ctx, game, n)
+	}
+	if e, ok := strategy.(Explainer); ok {
+		return e.Explain(game, n)
+	}
+	guess := GuessContext(ctx, strategy, game)
+	return guess, []Candidate{{GuessStats: game.Stats(guess)}}
+}
+
+// explainFallback is Explain for a strategy handing the guess to its
+// fallback, honoring ctx. It explains only when n > 0.
+func explainFallback(ctx context.Context, fallback Strategy, game *Game, n int) (Word, []Candidate) {
+	if n <= 0 {
+		return GuessContext(ctx, fallback, game), nil
+	}
+	return ExplainContext(ctx, fallback, game, n)
+}
+
+// explainOnly is Explain for a strategy with a single candidate. It
+// explains only when n > 0.
+func explainOnly(game *Game, guess Word, score float64, n int) (Word, []Candidate) {
+	if n <= 0 {
+		return guess, nil
+	}
+	return guess, []Candidate{{game.Stats(guess), score}}
+}
+
+// rankCandidates returns up to n of the candidates, best first
+// according to their scores, with choice first among equals. less
+// tells if one score is better than another. Only the first
+// len(scores) candidates were scored, and those scored NaN weren't.
+func rankCandidates(game *Game, choice Word, candidates []Word, scores []float64,
+	less func(a, b float64) bool, n int) []Candidate {
+	var order []int
+	var seen = make(map[Word]bool, len(scores))
+	for idx := range scores {
+		if !math.IsNaN(scores[idx]) && !seen[candidates[idx]] {
+			seen[candidates[idx]] = true
+			order = append(order, idx)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := scores[order[i]], scores[order[j]]
+		if less(a, b) || less(b, a) {
+			return less(a, b)
+		}
+		return candidates[order[i]] == choice
+	})
+	if len(order) > n {
+		order = order[:n]
+	}
+	var ranked = make([]Candidate, len(order))
+	for i, idx := range order {
+		ranked[i] = Candidate{game.Stats(candidates[idx]), scores[idx]}
+	}
+	return ranked
+}
@@ -0,0 +1,67 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	fallback := constStrategy(mkw("zzzzz"))
+	strategies := map[string]func() Explainer{
+		"filtering": func() Explainer {
+			return NewFilteringStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+		"entropy": func() Explainer {
+			return NewEntropyStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+		"minimax": func() Explainer {
+			return NewMinimaxStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+	}
+	metric := map[string]func(c Candidate) float64{
+		"filtering": func(c Candidate) float64 { return c.ExpectedRemaining },
+		"entropy":   func(c Candidate) float64 { return -c.Entropy },
+		"minimax":   func(c Candidate) float64 { return float64(c.WorstCase) },
+	}
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("arbas"), mkm("gg.gg"))
+
+	for name, mk := range strategies {
+		expect := mk().Guess(&game)
+		guess, ranked := mk().Explain(&game, 10)
+		assert.Equal(t, expect, guess, name)
+		assert.Len(t, ranked, 10, name)
+		assert.Equal(t, guess, ranked[0].Word, name)
+		seen := make(map[Word]bool)
+		for idx, c := range ranked {
+			assert.False(t, seen[c.Word], name)
+			seen[c.Word] = true
+			assert.InDelta(t, metric[name](c), metric[name](Candidate{GuessStats: game.Stats(c.Word)}), 1e-9)
+			if name == "entropy" {
+				assert.InDelta(t, c.Entropy, c.Score, 1e-9, name)
+			} else {
+				assert.InDelta(t, metric[name](c), c.Score, 1e-9, name)
+			}
+			if idx > 0 {
+				assert.LessOrEqual(t, metric[name](ranked[idx-1]), metric[name](c), name)
+			}
+		}
+
+		// Guessing the last possible answer needs no explanation.
+		last := NewGameWithAnswers(globalWords, []Word{mkw("areas")}, nil)
+		guess, ranked = mk().Explain(&last, 10)
+		assert.Equal(t, mkw("areas"), guess, name)
+		assert.Len(t, ranked, 1, name)
+	}
+
+	// Strategies that can't explain themselves only give their guess.
+	guess, ranked := Explain(fallback, &game, 10)
+	assert.Equal(t, Word(fallback), guess)
+	assert.Equal(t, []Candidate{{GuessStats: game.Stats(guess)}}, ranked)
+
+	hailmary := NewHailMary(strategies["minimax"](), fallback)
+	guess, ranked = Explain(hailmary, &game, 3)
+	assert.Equal(t, strategies["minimax"]().Guess(&game), guess)
+	assert.Len(t, ranked, 3)
+}
@@ -1,19 +1,23 @@
 package wordle
 
 import (
+	"context"
+	"math"
 	"math/rand"
 )
 
 type FilteringStrategy struct {
-	rng       *rand.Rand
-	log       Logger
+	rng *rand.Rand
+	log Logger
 	// Fallback strategy when there are too many choices
-	fallback  Strategy
+	fallback Strategy
 	// Use the fallback strategy when this many words remain
 	threshold int
 	// If several words are equally filtering, use this scoring to
 	// weight them and then choose randomly.
 	tiebreaker Scoring
+	// Number of goroutines evaluating candidates.
+	workers int
 }
 
 // Select the word that filters the most from the Possible game words.
@@ -34,37 +38,95 @@ type FilteringStrategy struct {
 // and N) are in 3 of the 5 possible answers, guarantees finding the
 // solution in 1 or 2 additional guesses.
 func NewFilteringStrategy(rng *rand.Rand, log Logger, fallback Strategy, threshold int, tiebreaker Scoring) *FilteringStrategy {
-	return &FilteringStrategy{rng, log, fallback, threshold, tiebreaker}
+	return &FilteringStrategy{rng, log, fallback, threshold, tiebreaker, 1}
+}
+
+// SetWorkers spreads the evaluation of candidate guesses across the
+// given number of goroutines. The choice of guess doesn't depend on
+// the number of workers.
+func (n *FilteringStrategy) SetWorkers(workers int) {
+	n.workers = workers
 }
 
 func (n FilteringStrategy) Guess(game *Game) Word {
+	return n.GuessContext(context.Background(), game)
+}
+
+// GuessContext is Guess, but when ctx is done it stops evaluating
+// candidates and chooses among those evaluated so far. If there were
+// none, it asks the fallback strategy.
+func (n FilteringStrategy) GuessContext(ctx context.Context, game *Game) Word {
+	guess, _ := n.guess(ctx, game, 0)
+	return guess
+}
+
+// Explain scores candidates by the expected number of possible
+// answers they leave.
+func (n FilteringStrategy) Explain(game *Game, top int) (Word, []Candidate) {
+	return n.guess(context.Background(), game, top)
+}
+
+// ExplainContext is Explain, but only of the candidates evaluated
+// before ctx is done.
+func (n FilteringStrategy) ExplainContext(ctx context.Context, game *Game, top int) (Word, []Candidate) {
+	return n.guess(ctx, game, top)
+}
+
+// guess implements GuessContext, and ExplainContext when top > 0.
+func (n FilteringStrategy) guess(ctx context.Context, game *Game, top int) (Word, []Candidate) {
 	var possible = game.PossibleAnswers()
-	if len(possible) > n.threshold {
-		return n.fallback.Guess(game)
+	if len(possible) > n.threshold || len(possible) == 0 {
+		return explainFallback(ctx, n.fallback, game, top)
 	}
 	if len(possible) == 1 {
-		return possible[0]
+		return explainOnly(game, possible[0], 0, top)
 	}
 
 	// Our candidate words to play are all possible answers plus a
 	// random sample of impossible answers. Among these we'll
 	// choose the one that filters the best, on average.
-	var candidates = sample(n.rng, game.words, n.threshold)
+	var candidates = sample(n.rng, game.Playable(game.words), n.threshold)
 	candidates = append(candidates, possible...)
 
-	var choices []Word
-	var choiceRemaining = -1
-	for _, candidate := range candidates {
+	// Evaluate every candidate, then merge the results in order so
+	// that the choice doesn't depend on how the work was split up.
+	var index = game.table.Index(possible)
+	var workers = n.workers
+	if workers < 1 {
+		workers = 1
+	}
+	var buckets = make([]matchBuckets, workers)
+	var codes = make([][]uint8, workers)
+	for w := range codes {
+		codes[w] = make([]uint8, len(possible))
+	}
+	var remainings = make([]int, len(candidates))
+	parallelFor(workers, len(candidates), func(w, idx int) {
+		if done(ctx) {
+			remainings[idx] = -1 // not evaluated
+			return
+		}
+		// Playing the candidate against an answer leaves exactly the
+		// answers that share its Match, so we can count them
+		// directly rather than filtering for each answer.
+		game.table.Codes(candidates[idx], possible, index, codes[w])
+		buckets[w].count(codes[w])
 		var remaining = 0
-		for _, answer := range possible {
-			if candidate == answer {
-				continue
-			}
-			future := game.Guess(candidate, candidate.Match(answer))
-			remaining += len(future.PossibleAnswers())
-			if choiceRemaining >= 0 && remaining > choiceRemaining {
-				break // bail early if this isn't going to work
+		for _, code := range codes[w] {
+			if code == wonCode {
+				continue // the candidate is the answer
 			}
+			remaining += buckets[w][code]
+		}
+		remainings[idx] = remaining
+	})
+
+	var choices []Word
+	var choiceRemaining = -1
+	for idx, candidate := range candidates {
+		var remaining = remainings[idx]
+		if remaining < 0 {
+			continue
 		}
 		if choiceRemaining < 0 || remaining < choiceRemaining {
 			choices = choices[:0] // truncate
@@ -74,20 +136,35 @@ func (n FilteringStrategy) Guess(game *Game) Word {
 			choices = append(choices, candidate)
 		}
 	}
-	var idx int;
+	if len(choices) == 0 {
+		n.log.Printf("out of time before evaluating any candidates\n")
+		return explainFallback(ctx, n.fallback, game, top)
+	}
+	var idx int
 	if len(choices) > 1 {
 		weights := n.tiebreaker.Weights(choices)
 		idx = weightedSample(n.rng, 2.0, weights)
 	} else {
 		idx = 0
 	}
-	choice := choices[idx];
+	choice := choices[idx]
 	n.log.Printf("%s filtered an avg of %f%% of words, chosen from %d choices\n",
 		choice,
 		100.0*(1-float64(choiceRemaining)/float64(len(possible)*(len(possible)-1))),
 		len(choices),
 	)
-	return choice
+	if top <= 0 {
+		return choice, nil
+	}
+	var scores = make([]float64, len(candidates))
+	for idx, remaining := range remainings {
+		scores[idx] = math.NaN()
+		if remaining >= 0 {
+			scores[idx] = float64(remaining) / float64(len(possible))
+		}
+	}
+	return choice, rankCandidates(game, choice, candidates, scores,
+		func(a, b float64) bool { return a < b }, top)
 }
 
 // sample returns an array of n words chosen randomly, without
@@ -107,3 +184,21 @@ func sample[T any](rng *rand.Rand, arr []T, n int) []T {
 	}
 	return samples
 }
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "filtering",
+		Description: "Guess the word that leaves the fewest possible answers on average",
+		Params: []Param{
+			{Name: "fallback", Kind: StrategyParam, Description: "Strategy used when too many answers remain", Default: "weighted"},
+			{Name: "threshold", Kind: IntParam, Description: "Possible answers above which the fallback is used", Default: 150},
+			{Name: "tiebreaker", Kind: ScoringParam, Description: "Scoring to choose among equally good guesses", Default: "freq"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			filtering := NewFilteringStrategy(rng, env.Log, args.Strategy("fallback"),
+				args.Int("threshold"), args.Scoring("tiebreaker"))
+			filtering.SetWorkers(env.Workers)
+			return filtering, nil
+		},
+	})
+}
@@ -9,7 +9,7 @@ import (
 func TestFilteringPlay(t *testing.T) {
 	rng := mkRand(1)
 	fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
-	strategy := NewFilteringStrategy(rng, globalLog, fallback, 60)
+	strategy := NewFilteringStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring())
 	game := NewGame(globalWords, nil)
 	answer, err := ParseWord("cigar")
 	assert.Nil(t, err)
@@ -19,5 +19,25 @@ func TestFilteringPlay(t *testing.T) {
 		game = game.Guess(guess, match)
 	}
 	assert.True(t, game.Won())
-	assert.Len(t, game.Guesses, 4) // arbitrary, but detect if something changes
+	assert.Len(t, game.Guesses, 5) // arbitrary, but detect if something changes
+}
+
+func TestFilteringWorkers(t *testing.T) {
+	play := func(workers int) []Guess {
+		rng := mkRand(7)
+		fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
+		strategy := NewFilteringStrategy(rng, globalLog, fallback, 150, NewUniqueLettersScoring())
+		strategy.SetWorkers(workers)
+		game := NewGame(globalWords, nil)
+		answer := mkw("watch")
+		for !game.Over() {
+			guess := strategy.Guess(&game)
+			game = game.Guess(guess, guess.Match(answer))
+		}
+		return game.Guesses
+	}
+	expect := play(1)
+	for _, workers := range []int{2, 3, 16} {
+		assert.Equal(t, expect, play(workers), "%d workers", workers)
+	}
 }
@@ -1,7 +1,15 @@
 package wordle
 
+import (
+	"context"
+	"math/rand"
+)
+
 // Play a fixed opening sequence before continuing with a follow-on
-// strategy.
+// strategy. The opening stops early in hard mode, at the first word
+// that isn't allowed. It also stops for good once a Guess differs from
+// the opening, since the rest of it was planned around words that
+// weren't played.
 type Fixed struct {
 	open     []Word
 	followOn Strategy
@@ -12,9 +20,52 @@ func FixedStrategy(open []Word, followOn Strategy) Fixed {
 }
 
 func (f Fixed) Guess(game *Game) Word {
+	return f.GuessContext(context.Background(), game)
+}
+
+func (f Fixed) GuessContext(ctx context.Context, game *Game) Word {
+	if w, ok := f.opening(game); ok {
+		return w
+	}
+	return GuessContext(ctx, f.followOn, game)
+}
+
+func (f Fixed) Explain(game *Game, n int) (Word, []Candidate) {
+	return f.ExplainContext(context.Background(), game, n)
+}
+
+func (f Fixed) ExplainContext(ctx context.Context, game *Game, n int) (Word, []Candidate) {
+	if w, ok := f.opening(game); ok {
+		return w, []Candidate{{GuessStats: game.Stats(w)}}
+	}
+	return ExplainContext(ctx, f.followOn, game, n)
+}
+
+// opening returns the next word of the opening, if it's still being
+// played.
+func (f Fixed) opening(game *Game) (Word, bool) {
 	idx := len(game.Guesses)
-	if idx < len(f.open) {
-		return f.open[idx]
+	if idx < len(f.open) && game.CheckGuess(f.open[idx]) == nil {
+		for i, g := range game.Guesses {
+			if g.Word != f.open[i] {
+				return Word{}, false
+			}
+		}
+		return f.open[idx], true
 	}
-	return f.followOn.Guess(game)
+	return Word{}, false
+}
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "fixed",
+		Description: "Play a fixed opening, then another strategy",
+		Params: []Param{
+			{Name: "open", Kind: WordsParam, Description: "Opening guesses"},
+			{Name: "strategy", Kind: StrategyParam, Description: "Strategy after the opening"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return FixedStrategy(args.Words("open"), args.Strategy("strategy")), nil
+		},
+	})
 }
@@ -1,5 +1,9 @@
 package wordle
 
+import (
+	"fmt"
+)
+
 type Freq struct {
 	weights       map[Word]float64
 	defaultWeight float64
@@ -22,3 +26,23 @@ func (f *Freq) Weights(words []Word) []float64 {
 	}
 	return scores
 }
+
+func init() {
+	RegisterScoring(ScoringDef{
+		Name:        "freq",
+		Description: "Score words by how often they're used in English",
+		Params: []Param{
+			{Name: "default", Kind: FloatParam, Description: "Score of words without a frequency", Default: 1.0},
+		},
+		New: func(env *Env, args Args) (Scoring, error) {
+			if env.WordFrequencies == nil {
+				return nil, fmt.Errorf("no word frequencies")
+			}
+			weights, err := env.WordFrequencies()
+			if err != nil {
+				return nil, err
+			}
+			return NewFreq(weights, args.Float("default")), nil
+		},
+	})
+}
@@ -3,46 +3,205 @@ package wordle
 import (
 	"fmt"
 	"strings"
+	"unicode"
 )
 
 const GuessLimit = 6
 
 type Game struct {
-	// Guesses made by the player, up to GuessLimit.
+	// Guesses made by the player, up to the Limit.
 	Guesses []Guess
 	// All words that can be played.
 	words []Word
+	// All words that can be the answer, less those already used.
+	answers []Word
 	// Words removed from play.
 	removed []Word
 	// A cache of possibleAnswers answers, deduced from words and Guesses.
 	possibleAnswers []Word
+	// Precomputed matches, if any.
+	table *MatchTable
+	// In hard mode, guesses must reuse what earlier guesses revealed.
+	hard     bool
+	revealed Constraints
+	// Guesses allowed, if not GuessLimit.
+	limit int
 }
 
+// NewGame starts a Game where any of the given words can be played,
+// and any of them not yet used can be the answer.
 func NewGame(words, used []Word) Game {
+	return NewGameWithAnswers(words, words, used)
+}
+
+// NewGameWithAnswers starts a Game where any of the given words can
+// be played, but only the given answers can be the answer. The
+// official game never repeats an answer, so used answers can still be
+// played but can't be the answer.
+func NewGameWithAnswers(words, answers, used []Word) Game {
+	answers = unused(answers, used)
 	return Game{
 		Guesses:         make([]Guess, 0, GuessLimit),
 		words:           words,
+		answers:         answers,
 		removed:         nil,
-		possibleAnswers: words,
+		possibleAnswers: answers,
+		revealed:        NewConstraints(),
 	}
 }
 
-// Guess at the answer
+// Guess at the answer, returning the Game with the Guess recorded and
+// the possible answers narrowed to those consistent with the Match.
 //
-// TODO: the calculation of possible answers from the Match is not
-// correct because this implementation assumes that Match yellow, "y",
-// is set for _every_ matching instance of a character, when in fact
-// it's only set for the first _n_ characters where _n_ is the number
-// of instances of that character in the answer. For example, if I
-// guess "geese" and the answer is "embed", I'll get a match of
-// ".yy...", not ".yy.y" as this code expects.
+// Guess doesn't enforce hard mode; use CheckGuess first for guesses
+// that might not be allowed.
 func (game Game) Guess(word Word, match Match) Game {
 	var g = Guess{word, match}
 	game.Guesses = append(game.Guesses, g)
-	game.possibleAnswers = g.FilterPossible(game.possibleAnswers)
+	game.possibleAnswers = game.table.FilterPossible(g, game.possibleAnswers)
+	game.revealed = game.revealed.Merge(g.Constraints().Revealed())
 	return game
 }
 
+// SetHardMode requires that every guess uses the green letters
+// revealed so far in the same position, and includes the yellow
+// letters.
+func (game *Game) SetHardMode(hard bool) {
+	game.hard = hard
+}
+
+func (game Game) HardMode() bool {
+	return game.hard
+}
+
+// SetLimit allows the given number of guesses rather than GuessLimit,
+// as for the boards of a MultiGame.
+func (game *Game) SetLimit(limit int) {
+	game.limit = limit
+}
+
+// Limit returns the number of guesses allowed.
+func (game Game) Limit() int {
+	if game.limit > 0 {
+		return game.limit
+	}
+	return GuessLimit
+}
+
+// CheckGuess returns an error if the word isn't allowed as the next
+// guess, in the same style as the official game's hard mode.
+func (game Game) CheckGuess(word Word) error {
+	if !game.hard {
+		return nil
+	}
+	for idx, l := range word {
+		if c := game.revealed.Exact(idx); c != 0 && c != l {
+			return fmt.Errorf("%s letter must be %c", ordinal(idx+1), unicode.ToUpper(rune(c)))
+		}
+	}
+	var lc = word.LetterCounts()
+	for l := byte('a'); l <= 'z'; l++ {
+		if lc[l-'a'] < game.revealed.Min(l) {
+			return fmt.Errorf("Guess must contain %c", unicode.ToUpper(rune(l)))
+		}
+	}
+	return nil
+}
+
+// Playable returns the given words that are allowed as the next
+// guess: all of them, unless in hard mode.
+func (game Game) Playable(words []Word) []Word {
+	if !game.hard {
+		return words
+	}
+	var playable []Word
+	for _, w := range words {
+		if game.CheckGuess(w) == nil {
+			playable = append(playable, w)
+		}
+	}
+	return playable
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
+
+// UseMatchTable speeds up filtering, and strategies that compare many
+// guesses, with a table of precomputed matches.
+func (game *Game) UseMatchTable(table *MatchTable) {
+	game.table = table
+}
+
+// MatchTable returns the Game's table of precomputed matches, which
+// may be nil. A nil table computes matches as needed.
+func (game Game) MatchTable() *MatchTable {
+	return game.table
+}
+
+// unused returns the answers that aren't among the used words. With
+// no used words it returns answers itself, so that caches keyed on the
+// slice, like MatchTable's, still apply.
+func unused(answers, used []Word) []Word {
+	if len(used) == 0 {
+		return answers
+	}
+	var isUsed = make(map[Word]bool, len(used))
+	for _, w := range used {
+		isUsed[w] = true
+	}
+	var filtered = make([]Word, 0, len(answers))
+	for _, w := range answers {
+		if !isUsed[w] {
+			filtered = append(filtered, w)
+		}
+	}
+	return filtered
+}
+
+// Undo returns the Game as it was before the last Guess. Words
+// removed with RemoveWord stay removed.
+func (game Game) Undo() Game {
+	if len(game.Guesses) == 0 {
+		return game
+	}
+	return game.replay(game.Guesses[:len(game.Guesses)-1])
+}
+
+// replay returns the Game with the given Guesses in place of its own.
+func (game Game) replay(guesses []Guess) Game {
+	var replayed = game
+	replayed.Guesses = make([]Guess, 0, GuessLimit)
+	replayed.possibleAnswers = game.answers
+	replayed.revealed = NewConstraints()
+	replayed.removed = nil
+	for _, w := range game.removed {
+		replayed.RemoveWord(w)
+	}
+	for _, g := range guesses {
+		replayed = replayed.Guess(g.Word, g.Match)
+	}
+	return replayed
+}
+
+// anyPlayable returns the words a strategy can fall back on when the
+// Guesses rule out every answer, as when a Match was entered wrong.
+func (game Game) anyPlayable() []Word {
+	if playable := game.Playable(game.words); len(playable) > 0 {
+		return playable
+	}
+	return game.words
+}
+
 // Don't try Guess the given word. Useful if the official
 // game doesn't like a word that we choose.
 func (game *Game) RemoveWord(removed Word) {
@@ -57,12 +216,23 @@ func (game *Game) RemoveWord(removed Word) {
 	game.possibleAnswers = filtered
 }
 
+// Words returns all the words that can be played.
+func (game Game) Words() []Word {
+	return game.words
+}
+
+// Answers returns all the words that can be the answer, regardless
+// of the Guesses so far. Used answers aren't included.
+func (game Game) Answers() []Word {
+	return game.answers
+}
+
 func (game Game) PossibleAnswers() []Word {
 	return game.possibleAnswers
 }
 
 func (game Game) Over() bool {
-	if len(game.Guesses) >= GuessLimit {
+	if len(game.Guesses) >= game.Limit() {
 		return true
 	}
 	for _, g := range game.Guesses {
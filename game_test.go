@@ -0,0 +1,99 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameWithAnswers(t *testing.T) {
+	answers := []Word{mkw("cigar"), mkw("rebut"), mkw("sissy"), mkw("humph")}
+	game := NewGameWithAnswers(globalWords, answers, nil)
+	assert.Equal(t, globalWords, game.Words())
+	assert.Equal(t, answers, game.Answers())
+	assert.Equal(t, answers, game.PossibleAnswers())
+
+	// Probes needn't be possible answers
+	game = game.Guess(mkw("aahed"), mkm("..y.."))
+	assert.Equal(t, []Word{mkw("humph")}, game.PossibleAnswers())
+	assert.Equal(t, answers, game.Answers())
+}
+
+func TestGameUsed(t *testing.T) {
+	assert.Equal(t, globalAnswers, NewGameWithAnswers(globalWords, globalAnswers, nil).Answers())
+
+	used := []Word{mkw("cigar"), mkw("rebut"), mkw("aahed")}
+	game := NewGameWithAnswers(globalWords, globalAnswers, used)
+	assert.Len(t, game.Answers(), len(globalAnswers)-2)
+	assert.Equal(t, mkw("sissy"), game.Answers()[0])
+	assert.Equal(t, game.Answers(), game.PossibleAnswers())
+	assert.Equal(t, globalWords, game.Words())
+
+	// Used answers can be played, but can't be the answer.
+	game = game.Guess(mkw("cigar"), mkw("cigar").Match(mkw("cider")))
+	assert.NotContains(t, game.PossibleAnswers(), mkw("cigar"))
+	assert.Contains(t, game.PossibleAnswers(), mkw("cider"))
+	assert.Equal(t, game.Answers(), game.Undo().PossibleAnswers())
+
+	game = NewGame(globalWords, used)
+	assert.Len(t, game.Answers(), len(globalWords)-3)
+	assert.NotContains(t, game.Answers(), mkw("aahed"))
+}
+
+func TestFilteringPlayWithAnswers(t *testing.T) {
+	answers := ightAnswers()
+	rng := mkRand(1)
+	strategy := NewFilteringStrategy(rng, globalLog, NaiveStrategy(rng), 150, NewUniqueLettersScoring())
+	for _, answer := range answers {
+		game := NewGameWithAnswers(globalWords, answers, nil)
+		for !game.Over() {
+			guess := strategy.Guess(&game)
+			game = game.Guess(guess, guess.Match(answer))
+		}
+		assert.True(t, game.Won(), "%s", answer)
+	}
+}
+
+func TestHardMode(t *testing.T) {
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("crane"), mkm(".y..g"))
+	assert.Nil(t, game.CheckGuess(mkw("aahed")))
+	assert.Len(t, game.Playable(globalWords), len(globalWords))
+
+	game.SetHardMode(true)
+	assert.True(t, game.HardMode())
+	assert.EqualError(t, game.CheckGuess(mkw("aahed")), "5th letter must be E")
+	assert.EqualError(t, game.CheckGuess(mkw("abide")), "Guess must contain R")
+	assert.Nil(t, game.CheckGuess(mkw("rebbe")))
+	// Grey and misplaced letters may be reused in hard mode.
+	assert.Nil(t, game.CheckGuess(mkw("crore")))
+	for _, w := range game.Playable(globalWords) {
+		assert.Equal(t, byte('e'), w[4])
+		assert.True(t, w.Letters().Contains('r'))
+	}
+}
+
+func TestHardModeStrategies(t *testing.T) {
+	rng := mkRand(3)
+	fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
+	solver := NewSolver(globalWords, TotalGuesses, 5)
+	solver.SetHardMode(true)
+	strategies := map[string]Strategy{
+		"filtering": NewFilteringStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring()),
+		"entropy":   NewEntropyStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring()),
+		"minimax":   NewMinimaxStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring()),
+		"optimal":   NewOptimalStrategy(solver, fallback, 30),
+		"fixed":     FixedStrategy([]Word{mkw("crane"), mkw("sloth")}, fallback),
+	}
+	for name, strategy := range strategies {
+		for _, answer := range []Word{mkw("watch"), mkw("cigar"), mkw("night")} {
+			game := NewGame(globalWords, nil)
+			game.SetHardMode(true)
+			for !game.Over() {
+				guess := strategy.Guess(&game)
+				assert.Nil(t, game.CheckGuess(guess), "%s: %s for %s", name, guess, answer)
+				game = game.Guess(guess, guess.Match(answer))
+			}
+		}
+	}
+}
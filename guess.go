@@ -1,11 +1,34 @@
 package wordle
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Guess is the guess at an answer, along with the Match corresponding to that guess.
 type Guess struct {
 	Word  Word
 	Match Match
 }
 
+// ParseGuess parses a guess and its match separated by a colon, for
+// example "crane:..y.g".
+func ParseGuess(s string) (g Guess, err error) {
+	word, match, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return g, fmt.Errorf("Expected word:match, got %q", s)
+	}
+	if g.Word, err = ParseWord(word); err != nil {
+		return
+	}
+	g.Match, err = ParseMatch(match)
+	return
+}
+
+func (g Guess) String() string {
+	return fmt.Sprintf("%s:%s", g.Word, g.Match)
+}
+
 func (g Guess) MustInclude() (must Letters, mustNot Letters) {
 	for idx, c := range g.Word {
 		if g.Match.Used(idx) {
@@ -35,21 +58,8 @@ func (g Guess) GreenAllows(answer Word) bool {
 }
 
 // Filter a given list of words to include only those that are
-// possible answers given this Guess.
-func (g Guess) FilterPossible(words []Word) (possibleAnswers []Word) {
-	var mustInclude, mustNotInclude = g.MustInclude()
-	for _, w := range words {
-		var l = w.Letters()
-		if !mustInclude.Remove(l).Empty() {
-			continue
-		}
-		if !mustNotInclude.Intersect(l).Empty() {
-			continue
-		}
-		if !g.GreenAllows(w) {
-			continue
-		}
-		possibleAnswers = append(possibleAnswers, w)
-	}
-	return
+// possible answers given this Guess. A word is kept exactly when
+// Guess.Word.Match would produce this Guess's Match against it.
+func (g Guess) FilterPossible(words []Word) []Word {
+	return g.Constraints().Filter(words)
 }
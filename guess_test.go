@@ -89,3 +89,16 @@ func TestFilterPossible(t *testing.T) {
 	}
 	assert.Equal(t, expect, possible)
 }
+
+func TestParseGuess(t *testing.T) {
+	g, err := ParseGuess("CRANE:..y.g")
+	assert.NoError(t, err)
+	assert.Equal(t, Guess{mkw("crane"), mkm("..y.g")}, g)
+	assert.Equal(t, "crane:..y.G", g.String())
+	_, err = ParseGuess("crane")
+	assert.Error(t, err)
+	_, err = ParseGuess("cran:..y.g")
+	assert.Error(t, err)
+	_, err = ParseGuess("crane:..y.")
+	assert.Error(t, err)
+}
@@ -1,5 +1,10 @@
 package wordle
 
+import (
+	"context"
+	"math/rand"
+)
+
 type HailMary struct {
 	normal   Strategy
 	hailmary Strategy
@@ -12,8 +17,39 @@ func NewHailMary(normal, hailmary Strategy) HailMary {
 }
 
 func (h HailMary) Guess(game *Game) Word {
-	if len(game.Guesses) == GuessLimit-1 {
-		return h.hailmary.Guess(game)
+	return h.GuessContext(context.Background(), game)
+}
+
+func (h HailMary) GuessContext(ctx context.Context, game *Game) Word {
+	return GuessContext(ctx, h.strategy(game), game)
+}
+
+func (h HailMary) Explain(game *Game, n int) (Word, []Candidate) {
+	return Explain(h.strategy(game), game, n)
+}
+
+func (h HailMary) ExplainContext(ctx context.Context, game *Game, n int) (Word, []Candidate) {
+	return ExplainContext(ctx, h.strategy(game), game, n)
+}
+
+// strategy returns the strategy to play next.
+func (h HailMary) strategy(game *Game) Strategy {
+	if len(game.Guesses) == game.Limit()-1 {
+		return h.hailmary
 	}
-	return h.normal.Guess(game)
+	return h.normal
+}
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "hail-mary",
+		Description: "Play one strategy, then another for the final guess",
+		Params: []Param{
+			{Name: "strategy", Kind: StrategyParam, Description: "Strategy for all but the final guess"},
+			{Name: "final", Kind: StrategyParam, Description: "Strategy for the final guess", Default: "weighted"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NewHailMary(args.Strategy("strategy"), args.Strategy("final")), nil
+		},
+	})
 }
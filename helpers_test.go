@@ -8,7 +8,10 @@ import (
 )
 
 // global list of words used in testing
-var globalWords []Word = loadTestWords()
+var globalWords []Word = loadTestWords("words")
+
+// global list of official answers used in testing
+var globalAnswers []Word = loadTestWords("answers")
 
 // global log used in tests
 var globalLog Logger = &testLogger{}
@@ -38,8 +41,8 @@ func mkl(s string) Letters {
 	return NewLetters([]byte(s))
 }
 
-func loadTestWords() (words []Word) {
-	file, err := os.Open("words")
+func loadTestWords(path string) (words []Word) {
+	file, err := os.Open(path)
 	if err != nil {
 		panic(err)
 	}
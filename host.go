@@ -0,0 +1,48 @@
+package wordle
+
+// Host replies to each guess with its Match, like the official game.
+type Host interface {
+	Reply(game *Game, guess Word) Match
+}
+
+// AnswerHost is an ordinary host with a fixed answer.
+type AnswerHost struct {
+	answer Word
+}
+
+func NewAnswerHost(answer Word) AnswerHost {
+	return AnswerHost{answer}
+}
+
+func (h AnswerHost) Reply(game *Game, guess Word) Match {
+	return guess.Match(h.answer)
+}
+
+// AdversarialHost never commits to an answer. Like Absurdle, it
+// replies to each guess with the Match that keeps the most possible
+// answers alive, so playing against it measures a strategy's worst
+// case.
+//
+// Ties go to the Match revealing the least, that is with the lowest
+// Match.Code, and the host only concedes a win when every other
+// Match would leave no possible answers.
+type AdversarialHost struct{}
+
+func NewAdversarialHost() AdversarialHost {
+	return AdversarialHost{}
+}
+
+func (h AdversarialHost) Reply(game *Game, guess Word) Match {
+	var possible = game.PossibleAnswers()
+	var codes = make([]uint8, len(possible))
+	game.table.Codes(guess, possible, game.table.Index(possible), codes)
+	var buckets matchBuckets
+	buckets.count(codes)
+	var best = wonCode
+	for code, count := range buckets {
+		if code != wonCode && count > 0 && (best == wonCode || count > buckets[best]) {
+			best = code
+		}
+	}
+	return MatchFromCode(uint8(best))
+}
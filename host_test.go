@@ -0,0 +1,43 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswerHost(t *testing.T) {
+	game := NewGame(globalWords, nil)
+	host := NewAnswerHost(mkw("cloth"))
+	assert.Equal(t, mkm(".ygy."), host.Reply(&game, mkw("stock")))
+}
+
+func TestAdversarialHost(t *testing.T) {
+	answers := ightAnswers()
+	game := NewGameWithAnswers(globalWords, answers, nil)
+	host := NewAdversarialHost()
+
+	// Guessing a possible answer, the host keeps every other one.
+	match := host.Reply(&game, mkw("night"))
+	assert.Equal(t, mkm(".GGGG"), match)
+	game = game.Guess(mkw("night"), match)
+	assert.Len(t, game.PossibleAnswers(), len(answers)-1)
+
+	// The host concedes only when it has to.
+	game = NewGameWithAnswers(globalWords, []Word{mkw("night")}, nil)
+	assert.True(t, host.Reply(&game, mkw("night")).Won())
+	assert.Equal(t, mkm("....."), host.Reply(&game, mkw("abbey")))
+}
+
+func TestAdversarialPlay(t *testing.T) {
+	rng := mkRand(1)
+	strategy := NewMinimaxStrategy(rng, globalLog, NaiveStrategy(rng), len(globalWords), NewUniqueLettersScoring())
+	game := NewGameWithAnswers(globalWords, ightAnswers(), nil)
+	host := NewAdversarialHost()
+	for !game.Over() {
+		guess := strategy.Guess(&game)
+		game = game.Guess(guess, host.Reply(&game, guess))
+		assert.NotEmpty(t, game.PossibleAnswers())
+	}
+	assert.True(t, game.Won())
+}
@@ -1,10 +1,42 @@
 package wordle
 
+import (
+	"context"
+)
+
 // Strategy is used to choose the next word to play in the given Game.
 type Strategy interface {
 	Guess(w *Game) Word
 }
 
+// ContextStrategy is a Strategy that can be cut short. When ctx is
+// done, GuessContext returns the best guess it has found so far rather
+// than finishing its search.
+type ContextStrategy interface {
+	Strategy
+	GuessContext(ctx context.Context, game *Game) Word
+}
+
+// GuessContext asks strategy for its next guess, cutting the search
+// short when ctx is done if strategy is a ContextStrategy. Other
+// strategies ignore ctx.
+func GuessContext(ctx context.Context, strategy Strategy, game *Game) Word {
+	if s, ok := strategy.(ContextStrategy); ok {
+		return s.GuessContext(ctx, game)
+	}
+	return strategy.Guess(game)
+}
+
+// done reports whether ctx is done, without blocking.
+func done(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
 // Scoring assigns a score, or "weight", to each word in the given array.
 // The weights can be independent or dependent on the other words in the
 // array.
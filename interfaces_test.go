@@ -0,0 +1,48 @@
+package wordle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// constStrategy always guesses the same word.
+type constStrategy Word
+
+func (c constStrategy) Guess(game *Game) Word {
+	return Word(c)
+}
+
+func TestGuessContext(t *testing.T) {
+	fallback := constStrategy(mkw("zzzzz"))
+	strategies := map[string]func() Strategy{
+		"filtering": func() Strategy {
+			return NewFilteringStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+		"entropy": func() Strategy {
+			return NewEntropyStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+		"minimax": func() Strategy {
+			return NewMinimaxStrategy(mkRand(1), globalLog, fallback, 150, NewUniqueLettersScoring())
+		},
+	}
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("crane"), mkw("crane").Match(mkw("watch")))
+	assert.Less(t, len(game.PossibleAnswers()), 150)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for name, mk := range strategies {
+		expect := mk().Guess(&game)
+		assert.NotEqual(t, Word(fallback), expect, name)
+		assert.Equal(t, expect, GuessContext(context.Background(), mk(), &game), name)
+		// Out of time before any candidate is evaluated
+		assert.Equal(t, Word(fallback), GuessContext(cancelled, mk(), &game), name)
+
+		hailmary := NewHailMary(mk(), fallback)
+		assert.Equal(t, Word(fallback), GuessContext(cancelled, hailmary, &game), name)
+	}
+	// Strategies that don't take a context ignore it.
+	assert.Equal(t, Word(fallback), GuessContext(cancelled, fallback, &game))
+}
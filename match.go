@@ -71,6 +71,44 @@ func (m Match) Used(idx int) bool {
 	return maskSet(m.used, idx)
 }
 
+// MatchCodes is the number of distinct Match codes.
+const MatchCodes = 243 // 3^WordLen
+
+// wonCode is the Match.Code of a win.
+const wonCode = MatchCodes - 1
+
+// Code returns a compact encoding of the Match as a base-3 number in
+// [0, MatchCodes), with one digit per square: 0 for grey, 1 for
+// yellow and 2 for green.
+func (m Match) Code() uint8 {
+	var code uint8
+	for idx := WordLen - 1; idx >= 0; idx-- {
+		code *= 3
+		switch {
+		case maskSet(m.exact, idx):
+			code += 2
+		case maskSet(m.used, idx):
+			code += 1
+		}
+	}
+	return code
+}
+
+// MatchFromCode decodes a Match encoded with Match.Code.
+func MatchFromCode(code uint8) Match {
+	var m Match
+	for idx := 0; idx < WordLen; idx++ {
+		switch code % 3 {
+		case 2:
+			m.SetUsed(idx, true)
+		case 1:
+			m.SetUsed(idx, false)
+		}
+		code /= 3
+	}
+	return m
+}
+
 func maskSet(m byte, idx int) bool {
 	return m&(1<<idx) != 0
 }
@@ -20,3 +20,25 @@ func TestParseMatch(t *testing.T) {
 		assert.Equal(t, strings.Replace(s, "g", "G", -1), m.String())
 	}
 }
+
+func TestMatchCode(t *testing.T) {
+	assert.Equal(t, uint8(0), mkm(".....").Code())
+	assert.Equal(t, uint8(1), mkm("y....").Code())
+	assert.Equal(t, uint8(2*81), mkm("....g").Code())
+	assert.Equal(t, uint8(MatchCodes-1), mkm("ggggg").Code())
+	seen := map[uint8]bool{}
+	for exact := 0; exact < 32; exact++ {
+		for used := 0; used < 32; used++ {
+			if exact&^used != 0 {
+				continue
+			}
+			m := Match{byte(exact), byte(used)}
+			code := m.Code()
+			assert.Less(t, int(code), MatchCodes)
+			assert.False(t, seen[code])
+			seen[code] = true
+			assert.Equal(t, m, MatchFromCode(code))
+		}
+	}
+	assert.Len(t, seen, MatchCodes)
+}
@@ -0,0 +1,123 @@
+package wordle
+
+import (
+	"context"
+	"math/rand"
+)
+
+type MinimaxStrategy struct {
+	rng *rand.Rand
+	log Logger
+	// Fallback strategy when there are too many choices
+	fallback Strategy
+	// Use the fallback strategy when this many words remain
+	threshold int
+	// If several words leave the same worst case, use this scoring
+	// to weight them and then choose randomly.
+	tiebreaker Scoring
+}
+
+// Select the word that minimizes the worst case: the largest group
+// of possible answers that share the same Match for that word.
+//
+// FilteringStrategy optimizes the average case, which can still lose
+// to families of answers that differ by a single letter, such as
+// "_ight" or "_atch". Minimizing the largest bucket bounds how many
+// of them can remain after each guess.
+func NewMinimaxStrategy(rng *rand.Rand, log Logger, fallback Strategy, threshold int, tiebreaker Scoring) *MinimaxStrategy {
+	return &MinimaxStrategy{rng, log, fallback, threshold, tiebreaker}
+}
+
+func (n MinimaxStrategy) Guess(game *Game) Word {
+	return n.GuessContext(context.Background(), game)
+}
+
+// GuessContext is Guess, but when ctx is done it stops evaluating
+// candidates and chooses among those evaluated so far. If there were
+// none, it asks the fallback strategy.
+func (n MinimaxStrategy) GuessContext(ctx context.Context, game *Game) Word {
+	guess, _ := n.guess(ctx, game, 0)
+	return guess
+}
+
+// Explain scores candidates by the most possible answers they can
+// leave.
+func (n MinimaxStrategy) Explain(game *Game, top int) (Word, []Candidate) {
+	return n.guess(context.Background(), game, top)
+}
+
+// ExplainContext is Explain, but only of the candidates evaluated
+// before ctx is done.
+func (n MinimaxStrategy) ExplainContext(ctx context.Context, game *Game, top int) (Word, []Candidate) {
+	return n.guess(ctx, game, top)
+}
+
+// guess implements GuessContext, and ExplainContext when top > 0.
+func (n MinimaxStrategy) guess(ctx context.Context, game *Game, top int) (Word, []Candidate) {
+	var possible = game.PossibleAnswers()
+	if len(possible) > n.threshold || len(possible) == 0 {
+		return explainFallback(ctx, n.fallback, game, top)
+	}
+	if len(possible) == 1 {
+		return explainOnly(game, possible[0], 1, top)
+	}
+
+	var candidates = sample(n.rng, game.Playable(game.words), n.threshold)
+	candidates = append(candidates, possible...)
+
+	var choices []Word
+	var scores []float64
+	var choiceLargest = -1
+	var buckets matchBuckets
+	var index = game.table.Index(possible)
+	var codes = make([]uint8, len(possible))
+	for _, candidate := range candidates {
+		if done(ctx) {
+			break
+		}
+		game.table.Codes(candidate, possible, index, codes)
+		buckets.count(codes)
+		var largest = buckets.largest()
+		scores = append(scores, float64(largest))
+		if choiceLargest < 0 || largest < choiceLargest {
+			choices = choices[:0] // truncate
+			choices = append(choices, candidate)
+			choiceLargest = largest
+		} else if largest == choiceLargest {
+			choices = append(choices, candidate)
+		}
+	}
+	if len(choices) == 0 {
+		n.log.Printf("out of time before evaluating any candidates\n")
+		return explainFallback(ctx, n.fallback, game, top)
+	}
+	var idx int
+	if len(choices) > 1 {
+		weights := n.tiebreaker.Weights(choices)
+		idx = weightedSample(n.rng, 2.0, weights)
+	}
+	choice := choices[idx]
+	n.log.Printf("%s leaves at most %d of %d words, chosen from %d choices\n",
+		choice, choiceLargest, len(possible), len(choices))
+	if top <= 0 {
+		return choice, nil
+	}
+	return choice, rankCandidates(game, choice, candidates, scores,
+		func(a, b float64) bool { return a < b }, top)
+}
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "minimax",
+		Description: "Guess the word that leaves the fewest possible answers in the worst case",
+		Params: []Param{
+			{Name: "fallback", Kind: StrategyParam, Description: "Strategy used when too many answers remain", Default: "weighted"},
+			{Name: "threshold", Kind: IntParam, Description: "Possible answers above which the fallback is used", Default: 150},
+			{Name: "tiebreaker", Kind: ScoringParam, Description: "Scoring to choose among equally good guesses", Default: "freq"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NewMinimaxStrategy(rng, env.Log, args.Strategy("fallback"),
+				args.Int("threshold"), args.Scoring("tiebreaker")), nil
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimaxPlay(t *testing.T) {
+	rng := mkRand(1)
+	fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
+	strategy := NewMinimaxStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring())
+	game := NewGame(globalWords, nil)
+	answer, err := ParseWord("cigar")
+	assert.Nil(t, err)
+	for !game.Over() {
+		guess := strategy.Guess(&game)
+		match := guess.Match(answer)
+		game = game.Guess(guess, match)
+	}
+	assert.True(t, game.Won())
+}
+
+func TestMinimaxTrap(t *testing.T) {
+	// Guessing the "_ight" answers one at a time can take more than
+	// a dozen guesses. The minimax choice must split them up as well
+	// as any word can.
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("light"), mkm(".GGGG"))
+	strategy := NewMinimaxStrategy(mkRand(1), globalLog, NaiveStrategy(mkRand(1)), len(globalWords), NewUniqueLettersScoring())
+	guess := strategy.Guess(&game)
+	var buckets matchBuckets
+	buckets.partition(guess, game.PossibleAnswers())
+	largest := buckets.largest()
+	assert.Less(t, largest, len(game.PossibleAnswers())-1)
+	for _, w := range globalWords {
+		buckets.partition(w, game.PossibleAnswers())
+		assert.GreaterOrEqual(t, buckets.largest(), largest)
+	}
+}
@@ -0,0 +1,205 @@
+package wordle
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// MultiGame is a set of boards, as in Dordle, Quordle or Octordle,
+// that share one sequence of guesses. Each board has its own answer,
+// and a board takes no more guesses once it's won.
+type MultiGame struct {
+	// Words played, in order, across all boards.
+	Guesses []Word
+	Boards  []Game
+	// The game is lost after this many guesses.
+	Limit int
+}
+
+// MultiGuessLimit is the usual guess limit for n boards: 7 for
+// Dordle, 9 for Quordle and 13 for Octordle.
+func MultiGuessLimit(n int) int {
+	return n + 5
+}
+
+// NewMultiGame starts a MultiGame with n boards, each a new Game
+// with the given words and answers.
+func NewMultiGame(n, limit int, words, answers, used []Word) MultiGame {
+	var boards = make([]Game, n)
+	for idx := range boards {
+		boards[idx] = NewGameWithAnswers(words, answers, used)
+		boards[idx].SetLimit(limit)
+	}
+	return MultiGame{Boards: boards, Limit: limit}
+}
+
+// UseMatchTable sets the table of precomputed matches for every
+// board.
+func (m *MultiGame) UseMatchTable(table *MatchTable) {
+	for idx := range m.Boards {
+		m.Boards[idx].UseMatchTable(table)
+	}
+}
+
+// Guess plays word on every board that isn't yet won. matches has
+// the Match for each board, in order; those of won boards are
+// ignored.
+func (m MultiGame) Guess(word Word, matches []Match) MultiGame {
+	m.Guesses = append(m.Guesses[:len(m.Guesses):len(m.Guesses)], word)
+	var boards = make([]Game, len(m.Boards))
+	for idx, board := range m.Boards {
+		if !board.Won() {
+			board = board.Guess(word, matches[idx])
+		}
+		boards[idx] = board
+	}
+	m.Boards = boards
+	return m
+}
+
+// RemoveWord removes the word from play on every board.
+func (m *MultiGame) RemoveWord(removed Word) {
+	for idx := range m.Boards {
+		m.Boards[idx].RemoveWord(removed)
+	}
+}
+
+// Unsolved returns the indexes of the boards that aren't yet won.
+func (m MultiGame) Unsolved() []int {
+	var unsolved []int
+	for idx, board := range m.Boards {
+		if !board.Won() {
+			unsolved = append(unsolved, idx)
+		}
+	}
+	return unsolved
+}
+
+func (m MultiGame) Won() bool {
+	return len(m.Unsolved()) == 0
+}
+
+func (m MultiGame) Over() bool {
+	return m.Won() || len(m.Guesses) >= m.Limit
+}
+
+func (m MultiGame) String() string {
+	var b strings.Builder
+	for idx, w := range m.Guesses {
+		if idx > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%d: %s", idx+1, w)
+		for _, board := range m.Boards {
+			if idx < len(board.Guesses) {
+				fmt.Fprintf(&b, " %s", board.Guesses[idx].Match)
+			} else {
+				b.WriteString("      ")
+			}
+		}
+	}
+	return b.String()
+}
+
+// MultiStrategy is used to choose the next word to play on every
+// board of a MultiGame.
+type MultiStrategy interface {
+	Guess(m *MultiGame) Word
+}
+
+// FocusStrategy plays a single-board Strategy on one board at a
+// time, always the unsolved board with the fewest possible answers.
+// Boards that are down to their last possible answer are played
+// first, since they're a sure win.
+type FocusStrategy struct {
+	strategy Strategy
+}
+
+func NewFocusStrategy(strategy Strategy) FocusStrategy {
+	return FocusStrategy{strategy}
+}
+
+func (f FocusStrategy) Guess(m *MultiGame) Word {
+	var focus = -1
+	for _, idx := range m.Unsolved() {
+		n := len(m.Boards[idx].PossibleAnswers())
+		if n == 0 {
+			continue
+		}
+		if focus < 0 || n < len(m.Boards[focus].PossibleAnswers()) {
+			focus = idx
+		}
+	}
+	if focus < 0 {
+		focus = m.Unsolved()[0]
+	}
+	var board = m.Boards[focus]
+	return f.strategy.Guess(&board)
+}
+
+// MultiFilteringStrategy is FilteringStrategy across boards: it
+// chooses the word that leaves the fewest possible answers in total,
+// on average, across every unsolved board.
+type MultiFilteringStrategy struct {
+	rng *rand.Rand
+	log Logger
+	// Fallback strategy when there are too many choices
+	fallback MultiStrategy
+	// Use the fallback strategy when this many words remain, across
+	// all boards.
+	threshold int
+	// If several words are equally filtering, use this scoring to
+	// weight them and then choose randomly.
+	tiebreaker Scoring
+}
+
+func NewMultiFilteringStrategy(rng *rand.Rand, log Logger, fallback MultiStrategy, threshold int, tiebreaker Scoring) *MultiFilteringStrategy {
+	return &MultiFilteringStrategy{rng, log, fallback, threshold, tiebreaker}
+}
+
+func (n MultiFilteringStrategy) Guess(m *MultiGame) Word {
+	var unsolved = m.Unsolved()
+	var total int
+	var candidates []Word
+	var seen = make(map[Word]bool)
+	for _, idx := range unsolved {
+		for _, w := range m.Boards[idx].PossibleAnswers() {
+			total++
+			if !seen[w] {
+				seen[w] = true
+				candidates = append(candidates, w)
+			}
+		}
+	}
+	if total > n.threshold {
+		return n.fallback.Guess(m)
+	}
+	var board = m.Boards[unsolved[0]]
+	candidates = append(sample(n.rng, board.words, n.threshold), candidates...)
+
+	var choices []Word
+	var choiceRemaining = -1.0
+	for _, candidate := range candidates {
+		var remaining float64
+		for _, idx := range unsolved {
+			remaining += m.Boards[idx].Stats(candidate).ExpectedRemaining
+		}
+		if choiceRemaining < 0 || remaining < choiceRemaining {
+			choices = choices[:0] // truncate
+			choices = append(choices, candidate)
+			choiceRemaining = remaining
+		} else if remaining == choiceRemaining {
+			choices = append(choices, candidate)
+		}
+	}
+	var idx int
+	if len(choices) > 1 {
+		weights := n.tiebreaker.Weights(choices)
+		idx = weightedSample(n.rng, 2.0, weights)
+	}
+	choice := choices[idx]
+	n.log.Printf("%s leaves an avg of %f words across %d boards, chosen from %d choices\n",
+		choice, choiceRemaining, len(unsolved), len(choices))
+	return choice
+}
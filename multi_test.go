@@ -0,0 +1,85 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func playMulti(m MultiGame, strategy MultiStrategy, answers []Word) MultiGame {
+	for !m.Over() {
+		guess := strategy.Guess(&m)
+		matches := make([]Match, len(answers))
+		for idx, answer := range answers {
+			matches[idx] = guess.Match(answer)
+		}
+		m = m.Guess(guess, matches)
+	}
+	return m
+}
+
+func TestMultiGame(t *testing.T) {
+	m := NewMultiGame(2, MultiGuessLimit(2), globalWords, globalWords, nil)
+	assert.Equal(t, 7, m.Limit)
+	assert.Equal(t, 7, m.Boards[0].Limit())
+	assert.Equal(t, []int{0, 1}, m.Unsolved())
+	m = m.Guess(mkw("cigar"), []Match{mkm("ggggg"), mkm("....y")})
+	assert.Equal(t, []int{1}, m.Unsolved())
+	assert.False(t, m.Won())
+	m = m.Guess(mkw("rebut"), []Match{{}, mkm("ggggg")})
+	assert.True(t, m.Won())
+	assert.True(t, m.Over())
+	assert.Len(t, m.Boards[0].Guesses, 1)
+	assert.Len(t, m.Boards[1].Guesses, 2)
+	assert.Equal(t, "1: cigar GGGGG ....y\n2: rebut       GGGGG", m.String())
+}
+
+func TestQuordle(t *testing.T) {
+	answers := []Word{mkw("cigar"), mkw("rebut"), mkw("sissy"), mkw("humph")}
+	rng := mkRand(1)
+	single := NewFilteringStrategy(rng, globalLog, NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1),
+		60, NewUniqueLettersScoring())
+	focus := NewFocusStrategy(single)
+	strategies := map[string]MultiStrategy{
+		"focus":     focus,
+		"filtering": NewMultiFilteringStrategy(rng, globalLog, focus, 60, NewUniqueLettersScoring()),
+	}
+	for name, strategy := range strategies {
+		m := NewMultiGame(len(answers), MultiGuessLimit(len(answers)), globalWords, globalAnswers, nil)
+		m = playMulti(m, strategy, answers)
+		assert.True(t, m.Won(), "%s:\n%s", name, m)
+		for idx, board := range m.Boards {
+			last := board.Guesses[len(board.Guesses)-1]
+			assert.Equal(t, answers[idx], last.Word)
+		}
+	}
+}
+
+// Strategies on a board count down from the MultiGame's limit, not
+// GuessLimit.
+func TestMultiBoardLimit(t *testing.T) {
+	m := NewMultiGame(4, MultiGuessLimit(4), globalWords, globalAnswers, nil)
+	for _, w := range []string{"crane", "moist", "plumb", "fudgy", "whack"} {
+		m = m.Guess(mkw(w), make([]Match, 4))
+	}
+	board := &m.Boards[0]
+	assert.False(t, board.Over())
+	hailMary := NewHailMary(constStrategy(mkw("crane")), constStrategy(mkw("zzzzz")))
+	assert.Equal(t, mkw("crane"), hailMary.Guess(board))
+	for _, w := range []string{"vexed", "jinks", "azure"} {
+		m = m.Guess(mkw(w), make([]Match, 4))
+	}
+	assert.Equal(t, mkw("zzzzz"), hailMary.Guess(&m.Boards[0]))
+
+	game := NewGameWithAnswers(globalWords, globalAnswers, nil)
+	game.SetLimit(MultiGuessLimit(8))
+	game = game.Guess(mkw("crane"), mkm("G...."))
+	for idx := 0; idx < 5; idx++ {
+		game = game.Guess(mkw("zzzzz"), mkm("....."))
+	}
+	assert.False(t, game.Over())
+	// Guesses are left, so the solver plays rather than the fallback.
+	solver := NewSolver(globalWords, TotalGuesses, 5)
+	optimal := NewOptimalStrategy(solver, constStrategy(mkw("zzzzz")), 150)
+	assert.NotEqual(t, mkw("zzzzz"), optimal.Guess(&game))
+}
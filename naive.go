@@ -14,6 +14,19 @@ func NaiveStrategy(rng *rand.Rand) Naive {
 
 func (n Naive) Guess(game *Game) Word {
 	var possible = game.PossibleAnswers()
+	if len(possible) == 0 {
+		possible = game.anyPlayable()
+	}
 	var idx = n.rng.Intn(len(possible))
 	return possible[idx]
 }
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "naive",
+		Description: "Guess a possible answer at random",
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NaiveStrategy(rng), nil
+		},
+	})
+}
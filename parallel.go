@@ -0,0 +1,32 @@
+package wordle
+
+import (
+	"sync"
+)
+
+// parallelFor calls fn for each index in [0, n), splitting the
+// indexes into contiguous ranges across the given number of
+// goroutines. fn is passed the worker number, in [0, workers), so
+// that it can use per-worker scratch space.
+func parallelFor(workers, n int, fn func(worker, idx int)) {
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for idx := 0; idx < n; idx++ {
+			fn(0, idx)
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for idx := w * n / workers; idx < (w+1)*n/workers; idx++ {
+				fn(w, idx)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,26 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelFor(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 8, 20} {
+		seen := make([]int, 10)
+		used := make([]bool, 20)
+		parallelFor(workers, len(seen), func(w, idx int) {
+			seen[idx]++
+			used[w] = true
+		})
+		for idx, n := range seen {
+			assert.Equal(t, 1, n, "%d workers, index %d", workers, idx)
+		}
+		for w := range used {
+			if workers > 1 {
+				assert.Equal(t, w < workers && w < len(seen), used[w])
+			}
+		}
+	}
+}
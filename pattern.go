@@ -0,0 +1,72 @@
+package wordle
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParsePattern parses a crossword-style search pattern into
+// Constraints, so that searching and filtering by Guesses agree on
+// what a word must look like. The pattern is made of space separated
+// terms:
+//
+//   - s?a?e gives the letter at each position, or ? (or .) for any
+//     letter.
+//   - +r requires each letter given, and repeating a letter requires
+//     it that many times: +ee needs at least two Es.
+//   - -tio excludes each letter given from the word.
+func ParsePattern(s string) (Constraints, error) {
+	var c = NewConstraints()
+	var required, exact LetterCounts
+	var excluded Letters
+	for _, term := range strings.Fields(strings.ToLower(s)) {
+		switch term[0] {
+		case '+', '-':
+			if len(term) == 1 {
+				return c, fmt.Errorf("Unrecognized pattern: %s", term)
+			}
+			for _, l := range term[1:] {
+				if l < 'a' || l > 'z' {
+					return c, fmt.Errorf("Letter %c not allowed", l)
+				}
+				if term[0] == '+' {
+					required.Add(byte(l))
+				} else {
+					excluded = excluded.AddChar(byte(l))
+				}
+			}
+		default:
+			if len(term) != WordLen {
+				return c, fmt.Errorf("Unrecognized pattern: %s", term)
+			}
+			for idx, l := range term {
+				switch {
+				case l == '?' || l == '.':
+					// any letter
+				case l < 'a' || l > 'z':
+					return c, fmt.Errorf("Letter %c not allowed", l)
+				case c.Exact(idx) != 0 && c.Exact(idx) != byte(l):
+					return c, fmt.Errorf("%s letter can't be both %c and %c",
+						ordinal(idx+1), c.Exact(idx), l)
+				case c.Exact(idx) == 0:
+					c.SetExact(idx, byte(l))
+					exact.Add(byte(l))
+				}
+			}
+		}
+	}
+	for l := byte('a'); l <= 'z'; l++ {
+		var n = required[l-'a']
+		if exact[l-'a'] > n {
+			n = exact[l-'a']
+		}
+		if excluded.Contains(l) {
+			if n > 0 {
+				return c, fmt.Errorf("%c is both required and excluded", l)
+			}
+			c.SetMax(l, 0)
+		}
+		c.SetMin(l, n)
+	}
+	return c, nil
+}
@@ -0,0 +1,56 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePattern(t *testing.T) {
+	c, err := ParsePattern("s?a?e +r -tio")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, c.Filter(globalWords))
+	for _, w := range c.Filter(globalWords) {
+		assert.Equal(t, byte('s'), w[0])
+		assert.Equal(t, byte('a'), w[2])
+		assert.Equal(t, byte('e'), w[4])
+		assert.True(t, w.Letters().Contains('r'))
+		assert.False(t, w.Letters().Contains('t'))
+	}
+	assert.True(t, c.Allows(mkw("snare")))
+	assert.False(t, c.Allows(mkw("stare")))
+	assert.False(t, c.Allows(mkw("shake")))
+
+	// Repeated letters are minimum counts.
+	c, err = ParsePattern("+EE ....s")
+	assert.Nil(t, err)
+	assert.True(t, c.Allows(mkw("geeks")))
+	assert.False(t, c.Allows(mkw("bikes")))
+	assert.Equal(t, byte(2), c.Min('e'))
+
+	// The same words as filtering by a Guess, once the yellow letters
+	// are also ruled out where the Guess had them, which a pattern
+	// can't say.
+	c, err = ParsePattern("c???? +ra -ne")
+	assert.Nil(t, err)
+	guess := Guess{mkw("crane"), mkm("Gyy..")}
+	var expected []Word
+	for _, w := range c.Filter(globalAnswers) {
+		if w[1] != 'r' && w[2] != 'a' {
+			expected = append(expected, w)
+		}
+	}
+	assert.NotEmpty(t, expected)
+	assert.ElementsMatch(t, expected, guess.FilterPossible(globalAnswers))
+
+	_, err = ParsePattern("s?a? +r")
+	assert.EqualError(t, err, "Unrecognized pattern: s?a?")
+	_, err = ParsePattern("s???? -s")
+	assert.EqualError(t, err, "s is both required and excluded")
+	_, err = ParsePattern("s???? t????")
+	assert.EqualError(t, err, "1st letter can't be both s and t")
+	_, err = ParsePattern("+r1")
+	assert.EqualError(t, err, "Letter 1 not allowed")
+	_, err = ParsePattern("-")
+	assert.EqualError(t, err, "Unrecognized pattern: -")
+}
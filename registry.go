@@ -0,0 +1,305 @@
+package wordle
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ParamKind is the type of value a Param takes.
+type ParamKind int
+
+const (
+	IntParam      ParamKind = iota // int
+	FloatParam                     // float64
+	StringParam                    // string
+	WordsParam                     // []Word
+	StrategyParam                  // Strategy
+	ScoringParam                   // Scoring
+)
+
+func (k ParamKind) String() string {
+	switch k {
+	case IntParam:
+		return "int"
+	case FloatParam:
+		return "float"
+	case StringParam:
+		return "string"
+	case WordsParam:
+		return "words"
+	case StrategyParam:
+		return "strategy"
+	case ScoringParam:
+		return "scoring"
+	}
+	return fmt.Sprintf("ParamKind(%d)", int(k))
+}
+
+// Param describes a parameter of a registered Strategy or Scoring.
+type Param struct {
+	Name        string
+	Kind        ParamKind
+	Description string
+	// Value used when the parameter isn't given, or nil if it's
+	// required. The default of a StrategyParam or ScoringParam is the
+	// name of one to build with its own defaults.
+	Default interface{}
+}
+
+// Args are the values of a registered constructor's parameters, by
+// name. Each has the Go type of its ParamKind.
+type Args map[string]interface{}
+
+func (a Args) Int(name string) int           { return a[name].(int) }
+func (a Args) Float(name string) float64     { return a[name].(float64) }
+func (a Args) String(name string) string     { return a[name].(string) }
+func (a Args) Words(name string) []Word      { return a[name].([]Word) }
+func (a Args) Strategy(name string) Strategy { return a[name].(Strategy) }
+func (a Args) Scoring(name string) Scoring   { return a[name].(Scoring) }
+
+// StrategyDef is a Strategy that can be built by name.
+type StrategyDef struct {
+	Name        string
+	Description string
+	Params      []Param
+	// New builds the Strategy for a single game, using rng for any
+	// random choices. args has a value for every Param.
+	New func(env *Env, rng *rand.Rand, args Args) (Strategy, error)
+}
+
+// ScoringDef is a Scoring that can be built by name.
+type ScoringDef struct {
+	Name        string
+	Description string
+	Params      []Param
+	// New builds the Scoring. args has a value for every Param. It's
+	// called from Env.Shared, so mustn't use the Env's methods.
+	New func(env *Env, args Args) (Scoring, error)
+}
+
+var registry struct {
+	sync.RWMutex
+	strategies map[string]StrategyDef
+	scorings   map[string]ScoringDef
+}
+
+// RegisterStrategy makes a Strategy available by name, usually from
+// the init function of the package that implements it. It panics if
+// the name is already registered.
+func RegisterStrategy(def StrategyDef) {
+	registry.Lock()
+	defer registry.Unlock()
+	name := strings.ToLower(def.Name)
+	if _, ok := registry.strategies[name]; ok {
+		panic("wordle: strategy registered twice: " + name)
+	}
+	if registry.strategies == nil {
+		registry.strategies = make(map[string]StrategyDef)
+	}
+	registry.strategies[name] = def
+}
+
+// RegisterScoring makes a Scoring available by name, as
+// RegisterStrategy does for a Strategy.
+func RegisterScoring(def ScoringDef) {
+	registry.Lock()
+	defer registry.Unlock()
+	name := strings.ToLower(def.Name)
+	if _, ok := registry.scorings[name]; ok {
+		panic("wordle: scoring registered twice: " + name)
+	}
+	if registry.scorings == nil {
+		registry.scorings = make(map[string]ScoringDef)
+	}
+	registry.scorings[name] = def
+}
+
+// LookupStrategy returns the Strategy registered with the given name.
+func LookupStrategy(name string) (StrategyDef, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	def, ok := registry.strategies[strings.ToLower(name)]
+	return def, ok
+}
+
+// LookupScoring returns the Scoring registered with the given name.
+func LookupScoring(name string) (ScoringDef, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	def, ok := registry.scorings[strings.ToLower(name)]
+	return def, ok
+}
+
+// Strategies returns every registered Strategy, sorted by name.
+func Strategies() []StrategyDef {
+	registry.RLock()
+	defer registry.RUnlock()
+	var defs []StrategyDef
+	for _, def := range registry.strategies {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// Scorings returns every registered Scoring, sorted by name.
+func Scorings() []ScoringDef {
+	registry.RLock()
+	defer registry.RUnlock()
+	var defs []ScoringDef
+	for _, def := range registry.scorings {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// Env is what registered strategies and scorings are built with. The
+// same Env is used to build the strategies of every game, so they
+// share what's expensive to build, like scorings.
+type Env struct {
+	// Words that can be guessed
+	Words []Word
+	// Words that can be the answer
+	Answers []Word
+	Log     Logger
+	// Goroutines a strategy may use to evaluate guesses
+	Workers int
+	// Loads word frequencies, for the freq scoring.
+	WordFrequencies func() (map[Word]float64, error)
+	// Optional wrappers around every Strategy and Scoring built, for
+	// example to log or cache them.
+	WrapStrategy func(Strategy) Strategy
+	WrapScoring  func(Scoring) Scoring
+
+	mu     sync.Mutex
+	shared map[interface{}]interface{}
+}
+
+// Shared returns the value stored under key, calling fn to create it
+// the first time. Strategies use it for state that every game should
+// share, such as a Solver's memo. fn must not itself use the Env.
+func (env *Env) Shared(key interface{}, fn func() (interface{}, error)) (interface{}, error) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	if v, ok := env.shared[key]; ok {
+		return v, nil
+	}
+	v, err := fn()
+	if err != nil {
+		return nil, err
+	}
+	if env.shared == nil {
+		env.shared = make(map[interface{}]interface{})
+	}
+	env.shared[key] = v
+	return v, nil
+}
+
+// NewStrategy builds the named Strategy with the given args. Missing
+// args take their defaults.
+func (env *Env) NewStrategy(rng *rand.Rand, name string, args Args) (Strategy, error) {
+	def, ok := LookupStrategy(name)
+	if !ok {
+		return nil, fmt.Errorf("Unrecognized strategy: %s", name)
+	}
+	args, err := env.resolve(rng, def.Name, def.Params, args)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := def.New(env, rng, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", def.Name, err)
+	}
+	if env.WrapStrategy != nil {
+		strategy = env.WrapStrategy(strategy)
+	}
+	return strategy, nil
+}
+
+// NewScoring builds the named Scoring with the given args. Missing
+// args take their defaults. Scorings are shared: building the same
+// scoring with the same args again returns the first one.
+func (env *Env) NewScoring(name string, args Args) (Scoring, error) {
+	def, ok := LookupScoring(name)
+	if !ok {
+		return nil, fmt.Errorf("Unrecognized scoring: %s", name)
+	}
+	args, err := env.resolve(nil, def.Name, def.Params, args)
+	if err != nil {
+		return nil, err
+	}
+	// fmt prints maps sorted by key.
+	type scoringKey string
+	key := scoringKey(def.Name + fmt.Sprint(map[string]interface{}(args)))
+	v, err := env.Shared(key, func() (interface{}, error) {
+		scoring, err := def.New(env, args)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", def.Name, err)
+		}
+		if env.WrapScoring != nil {
+			scoring = env.WrapScoring(scoring)
+		}
+		return scoring, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(Scoring), nil
+}
+
+// resolve checks args against params, and fills in the defaults.
+func (env *Env) resolve(rng *rand.Rand, name string, params []Param, args Args) (Args, error) {
+	var resolved = make(Args, len(params))
+	for _, p := range params {
+		v, ok := args[p.Name]
+		if !ok {
+			if p.Default == nil {
+				return nil, fmt.Errorf("%s: missing %s", name, p.Name)
+			}
+			v = p.Default
+			switch p.Kind {
+			case StrategyParam:
+				s, err := env.NewStrategy(rng, v.(string), nil)
+				if err != nil {
+					return nil, err
+				}
+				v = s
+			case ScoringParam:
+				s, err := env.NewScoring(v.(string), nil)
+				if err != nil {
+					return nil, err
+				}
+				v = s
+			}
+		}
+		var typeOK bool
+		switch p.Kind {
+		case IntParam:
+			_, typeOK = v.(int)
+		case FloatParam:
+			_, typeOK = v.(float64)
+		case StringParam:
+			_, typeOK = v.(string)
+		case WordsParam:
+			_, typeOK = v.([]Word)
+		case StrategyParam:
+			_, typeOK = v.(Strategy)
+		case ScoringParam:
+			_, typeOK = v.(Scoring)
+		}
+		if !typeOK {
+			return nil, fmt.Errorf("%s: %s must be of type %s, not %T", name, p.Name, p.Kind, v)
+		}
+		resolved[p.Name] = v
+	}
+	for k := range args {
+		if _, ok := resolved[k]; !ok {
+			return nil, fmt.Errorf("%s: unknown parameter %s", name, k)
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,75 @@
+package wordle
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{"naive", "weighted", "top", "filtering", "entropy", "minimax",
+		"optimal", "hail-mary", "fixed"} {
+		_, ok := LookupStrategy(name)
+		assert.True(t, ok, name)
+	}
+	for _, name := range []string{"common", "diversity", "freq", "selective"} {
+		_, ok := LookupScoring(name)
+		assert.True(t, ok, name)
+	}
+	_, ok := LookupStrategy("Filtering")
+	assert.True(t, ok)
+	_, ok = LookupStrategy("freq")
+	assert.False(t, ok)
+	assert.Panics(t, func() { RegisterStrategy(StrategyDef{Name: "naive"}) })
+
+	defs := Strategies()
+	for idx := 1; idx < len(defs); idx++ {
+		assert.Less(t, defs[idx-1].Name, defs[idx].Name)
+	}
+}
+
+func TestEnvNewStrategy(t *testing.T) {
+	var loads int
+	env := &Env{
+		Words:   globalWords,
+		Answers: globalAnswers,
+		Log:     globalLog,
+		WordFrequencies: func() (map[Word]float64, error) {
+			loads++
+			return map[Word]float64{}, nil
+		},
+	}
+	rng := mkRand(1)
+	s, err := env.NewStrategy(rng, "filtering", Args{"threshold": 10})
+	assert.Nil(t, err)
+	f := s.(*FilteringStrategy)
+	assert.Equal(t, 10, f.threshold)
+	assert.IsType(t, &WeightedStrategy{}, f.fallback)
+	assert.IsType(t, &Freq{}, f.tiebreaker)
+	// The fallback and the tiebreaker share one freq scoring.
+	assert.Same(t, f.tiebreaker, f.fallback.(*WeightedStrategy).scoring)
+	assert.Equal(t, 1, loads)
+
+	_, err = env.NewStrategy(rng, "fixed", Args{"strategy": NaiveStrategy(rng)})
+	assert.EqualError(t, err, "fixed: missing open")
+	_, err = env.NewStrategy(rng, "naive", Args{"exp": 1.0})
+	assert.EqualError(t, err, "naive: unknown parameter exp")
+	_, err = env.NewStrategy(rng, "top", Args{"scoring": "freq"})
+	assert.EqualError(t, err, "top: scoring must be of type scoring, not string")
+	_, err = env.NewStrategy(rng, "optimal", Args{"objective": "best"})
+	assert.EqualError(t, err, "optimal: Unrecognized objective: best")
+	_, err = env.NewStrategy(rng, "bogus", nil)
+	assert.EqualError(t, err, "Unrecognized strategy: bogus")
+
+	// Solvers are shared by every strategy built with the same
+	// parameters.
+	o1, err := env.NewStrategy(rng, "optimal", nil)
+	assert.Nil(t, err)
+	o2, err := env.NewStrategy(rand.New(rand.NewSource(2)), "optimal", nil)
+	assert.Nil(t, err)
+	o3, err := env.NewStrategy(rng, "optimal", Args{"candidates": 5})
+	assert.Nil(t, err)
+	assert.Same(t, o1.(*OptimalStrategy).solver, o2.(*OptimalStrategy).solver)
+	assert.NotSame(t, o1.(*OptimalStrategy).solver, o3.(*OptimalStrategy).solver)
+}
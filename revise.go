@@ -0,0 +1,53 @@
+package wordle
+
+// Revision is a change to the Match of one of a Game's Guesses.
+type Revision struct {
+	// Index of the revised Guess in Game.Guesses
+	Index int
+	Match Match
+	// Number of answers possible after the revision
+	Possible int
+}
+
+// Revise returns the Game with the Match of the Guess at the given
+// index replaced.
+func (game Game) Revise(index int, match Match) Game {
+	var guesses = append([]Guess(nil), game.Guesses...)
+	guesses[index].Match = match
+	return game.replay(guesses)
+}
+
+// SuggestRevision finds the likeliest typo when the Guesses rule out
+// every answer: the change to a single tile of a single Match that
+// leaves the most possible answers. Among equals it prefers the latest
+// Guess. It returns false if there are possible answers already, or
+// if no such change leaves any.
+func (game Game) SuggestRevision() (Revision, bool) {
+	var best Revision
+	if len(game.PossibleAnswers()) > 0 {
+		return best, false
+	}
+	for index := len(game.Guesses) - 1; index >= 0; index-- {
+		var code = game.Guesses[index].Match.Code()
+		var place uint8 = 1
+		for idx := 0; idx < WordLen; idx++ {
+			var tile = code / place % 3
+			for color := uint8(0); color < 3; color++ {
+				if color == tile {
+					continue
+				}
+				var revised = code - tile*place + color*place
+				if revised == wonCode {
+					continue
+				}
+				var match = MatchFromCode(revised)
+				var possible = len(game.Revise(index, match).PossibleAnswers())
+				if possible > best.Possible {
+					best = Revision{index, match, possible}
+				}
+			}
+			place *= 3
+		}
+	}
+	return best, best.Possible > 0
+}
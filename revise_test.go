@@ -0,0 +1,77 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUndo(t *testing.T) {
+	game := NewGameWithAnswers(globalWords, globalAnswers, nil)
+	game.SetHardMode(true)
+	game.RemoveWord(mkw("cigar"))
+	first := game.Guess(mkw("crane"), mkm("gy..."))
+	second := first.Guess(mkw("court"), mkm("g..g."))
+
+	undone := second.Undo()
+	assert.Equal(t, first.Guesses, undone.Guesses)
+	assert.Equal(t, first.PossibleAnswers(), undone.PossibleAnswers())
+	assert.NotContains(t, undone.PossibleAnswers(), mkw("cigar"))
+	assert.Equal(t, first.CheckGuess(mkw("cider")), undone.CheckGuess(mkw("cider")))
+	assert.True(t, undone.HardMode())
+	// The Game undone from is unchanged.
+	assert.Len(t, second.Guesses, 2)
+
+	undone = undone.Undo()
+	assert.Empty(t, undone.Guesses)
+	assert.Len(t, undone.PossibleAnswers(), len(globalAnswers)-1)
+	assert.Empty(t, undone.Undo().Guesses)
+}
+
+func TestSuggestRevision(t *testing.T) {
+	answer := mkw("cigar")
+	game := NewGameWithAnswers(globalWords, globalAnswers, nil)
+	game = game.Guess(mkw("crane"), mkw("crane").Match(answer))
+	_, ok := game.SuggestRevision()
+	assert.False(t, ok, "nothing needs revising")
+
+	// The A of "salty" mistyped as grey.
+	game = game.Guess(mkw("salty"), mkm("....."))
+	assert.Empty(t, game.PossibleAnswers())
+	revision, ok := game.SuggestRevision()
+	assert.True(t, ok)
+	revised := game.Revise(revision.Index, revision.Match)
+	assert.NotEmpty(t, revised.PossibleAnswers())
+	assert.Len(t, revised.PossibleAnswers(), revision.Possible)
+	assert.Equal(t, mkm("....."), game.Guesses[1].Match)
+
+	// Only the last tile can be wrong, and not as a win.
+	game = NewGameWithAnswers(globalWords, globalAnswers, nil)
+	game = game.Guess(mkw("crane"), mkm("ggggy"))
+	revision, ok = game.SuggestRevision()
+	assert.True(t, ok)
+	assert.Equal(t, Revision{0, mkm("gggg."), 1}, revision)
+	assert.Equal(t, []Word{mkw("crank")}, game.Revise(0, revision.Match).PossibleAnswers())
+}
+
+func TestStrategiesWithoutAnswers(t *testing.T) {
+	rng := mkRand(1)
+	scoring := NewUniqueLettersScoring()
+	fallback := NaiveStrategy(rng)
+	solver := NewSolver(globalWords, TotalGuesses, 5)
+	strategies := map[string]Strategy{
+		"naive":     fallback,
+		"weighted":  NewWeightedStrategy(rng, scoring, 1),
+		"top":       NewTop(rng, scoring),
+		"filtering": NewFilteringStrategy(rng, globalLog, fallback, 150, scoring),
+		"entropy":   NewEntropyStrategy(rng, globalLog, fallback, 150, scoring),
+		"minimax":   NewMinimaxStrategy(rng, globalLog, fallback, 150, scoring),
+		"optimal":   NewOptimalStrategy(solver, fallback, 150),
+	}
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("crane"), mkm("ggggy"))
+	assert.Empty(t, game.PossibleAnswers())
+	for name, strategy := range strategies {
+		assert.NotPanics(t, func() { strategy.Guess(&game) }, name)
+	}
+}
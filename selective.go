@@ -27,3 +27,13 @@ func (x SelectiveScoring) Weights(words []Word) []float64 {
 	}
 	return scores
 }
+
+func init() {
+	RegisterScoring(ScoringDef{
+		Name:        "selective",
+		Description: "Score words by how common their letters are at each position",
+		New: func(env *Env, args Args) (Scoring, error) {
+			return NewSelectiveScale(), nil
+		},
+	})
+}
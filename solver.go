@@ -0,0 +1,432 @@
+package wordle
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Objective is the cost of a DecisionTree that a Solver minimizes.
+type Objective int
+
+const (
+	// TotalGuesses is the sum of the guesses needed to find each
+	// answer, and so minimizes the average.
+	TotalGuesses Objective = iota
+	// WorstCase is the most guesses needed to find any answer.
+	WorstCase
+)
+
+// DecisionTree is a plan for finding any of a set of answers: play
+// Guess, then continue with the subtree for the Match.
+type DecisionTree struct {
+	Guess Word
+	// Subtrees for each Match other than a win.
+	Next map[Match]*DecisionTree
+	// Number of answers the tree finds.
+	Answers int
+	// Cost of finding those answers, according to the Objective.
+	Cost int
+}
+
+// Depth returns the most guesses the tree needs to find an answer.
+func (t *DecisionTree) Depth() int {
+	var depth int
+	for _, next := range t.Next {
+		if d := next.Depth(); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+// Solver computes optimal DecisionTrees by exhaustive search,
+// pruning guesses that can't beat the best tree found so far.
+// Subtrees are memoized by their set of answers, so a Solver can be
+// reused to cheaply solve positions it has already seen. A Solver is
+// safe for concurrent use.
+type Solver struct {
+	guesses   []Word
+	objective Objective
+	// Consider only this many of the most promising guesses at each
+	// step, or all guesses when 0.
+	candidates int
+	// Only guess possible answers, which are always allowed in hard
+	// mode.
+	hard bool
+	// Guards memo, so that searches share it but run concurrently.
+	mu   sync.Mutex
+	memo map[string]solution
+}
+
+type solution struct {
+	tree *DecisionTree
+	cost int
+	// If false, cost is only a lower bound.
+	exact bool
+}
+
+const infeasible = math.MaxInt32
+
+// Build a Solver that chooses among the given guesses. When the
+// candidates limit is 0, every guess is considered and the trees are
+// truly optimal; otherwise only the most promising guesses, judged by
+// the expected size of the remaining answers, are searched, and the
+// trees may not be optimal.
+func NewSolver(guesses []Word, objective Objective, candidates int) *Solver {
+	return &Solver{
+		guesses:    guesses,
+		objective:  objective,
+		candidates: candidates,
+		memo:       make(map[string]solution),
+	}
+}
+
+// SetHardMode restricts the Solver to guessing possible answers, so
+// that its trees can be played in hard mode. The trees are then
+// optimal among those that only guess possible answers. It must be
+// called before the Solver is used.
+func (s *Solver) SetHardMode(hard bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if hard != s.hard {
+		s.hard = hard
+		s.memo = make(map[string]solution)
+	}
+}
+
+// Solve returns an optimal DecisionTree for finding any of the given
+// answers in at most the given number of guesses, or nil if that
+// isn't possible.
+func (s *Solver) Solve(answers []Word, guesses int) *DecisionTree {
+	return s.SolveContext(context.Background(), answers, guesses)
+}
+
+// SolveContext is Solve, but when ctx is done it stops searching and
+// returns the best tree found so far, which may not be optimal, or nil
+// if it hasn't found one yet.
+func (s *Solver) SolveContext(ctx context.Context, answers []Word, guesses int) *DecisionTree {
+	tree, _ := s.solve(ctx, answers, guesses, infeasible)
+	return tree
+}
+
+// solve finds the best tree for answers that costs less than beta. If
+// there is none, it returns nil and a lower bound on the cost.
+func (s *Solver) solve(ctx context.Context, answers []Word, depth int, beta int) (*DecisionTree, int) {
+	var n = len(answers)
+	switch {
+	case n == 0:
+		return nil, 0
+	case n == 1:
+		if depth < 1 {
+			return nil, infeasible
+		}
+		return &DecisionTree{Guess: answers[0], Answers: 1, Cost: 1}, 1
+	case depth < 2:
+		return nil, infeasible
+	}
+	var key = solverKey(answers, depth)
+	s.mu.Lock()
+	sol, ok := s.memo[key]
+	s.mu.Unlock()
+	if ok && (sol.exact || sol.cost >= beta) {
+		return sol.tree, sol.cost
+	}
+
+	var best *DecisionTree
+	var bestCost = beta
+	var floor = s.lowerBound(n)
+	for _, guess := range s.rank(answers) {
+		if ctx.Err() != nil {
+			break
+		}
+		groups := partition(guess, answers)
+		if len(groups) == 1 && !groups[0].match.Won() {
+			continue // learns nothing
+		}
+		var bounds = make([]int, len(groups))
+		for idx, g := range groups {
+			if !g.match.Won() {
+				bounds[idx] = s.lowerBound(len(g.answers))
+			}
+		}
+		var cost = s.combine(n, bounds)
+		if cost >= bestCost {
+			continue
+		}
+		var next = make(map[Match]*DecisionTree, len(groups))
+		for idx, g := range groups {
+			if g.match.Won() {
+				continue
+			}
+			var subBeta int
+			switch s.objective {
+			case TotalGuesses:
+				subBeta = bestCost - (cost - bounds[idx])
+			case WorstCase:
+				subBeta = bestCost - 1
+			}
+			sub, subCost := s.solve(ctx, g.answers, depth-1, subBeta)
+			if sub == nil || subCost >= subBeta {
+				cost = infeasible
+				break
+			}
+			bounds[idx] = subCost
+			cost = s.combine(n, bounds)
+			next[g.match] = sub
+		}
+		if cost < bestCost {
+			best = &DecisionTree{Guess: guess, Next: next, Answers: n, Cost: cost}
+			bestCost = cost
+			if bestCost <= floor {
+				break // can't do better
+			}
+		}
+	}
+	if ctx.Err() != nil {
+		// The search was cut short, so the result is neither optimal
+		// nor a bound, and isn't remembered.
+		return best, bestCost
+	}
+	sol = solution{best, bestCost, best != nil}
+	s.mu.Lock()
+	s.memo[key] = sol
+	s.mu.Unlock()
+	return sol.tree, sol.cost
+}
+
+// combine computes the cost of a guess for n answers given the cost
+// of each group of answers it leaves.
+func (s *Solver) combine(n int, costs []int) int {
+	var total, worst int
+	for _, c := range costs {
+		if c >= infeasible {
+			return infeasible
+		}
+		total += c
+		if c > worst {
+			worst = c
+		}
+	}
+	if s.objective == WorstCase {
+		return worst + 1
+	}
+	return total + n
+}
+
+// lowerBound of the cost to find n answers: at best one is found
+// with the next guess and the rest with the guess after that.
+func (s *Solver) lowerBound(n int) int {
+	switch {
+	case n == 0:
+		return 0
+	case n == 1:
+		return 1
+	case s.objective == WorstCase:
+		return 2
+	default:
+		return 2*n - 1
+	}
+}
+
+// rank orders guesses by how promising they are for the given
+// answers: the fewest answers expected to remain, preferring possible
+// answers when otherwise equal.
+func (s *Solver) rank(answers []Word) []Word {
+	var possible = make(map[Word]bool, len(answers))
+	for _, a := range answers {
+		possible[a] = true
+	}
+	type ranked struct {
+		word     Word
+		score    int
+		possible bool
+	}
+	var scores = make([]ranked, 0, len(s.guesses)+len(answers))
+	var buckets matchBuckets
+	var score = func(w Word) ranked {
+		buckets.partition(w, answers)
+		var sum int
+		for _, count := range buckets {
+			sum += count * count
+		}
+		return ranked{w, sum, possible[w]}
+	}
+	for _, w := range s.guesses {
+		if !possible[w] && !s.hard {
+			scores = append(scores, score(w))
+		}
+	}
+	for _, w := range answers {
+		scores = append(scores, score(w))
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].score != scores[j].score {
+			return scores[i].score < scores[j].score
+		}
+		return scores[i].possible && !scores[j].possible
+	})
+	if s.candidates > 0 && len(scores) > s.candidates {
+		scores = scores[:s.candidates]
+	}
+	var words = make([]Word, len(scores))
+	for idx, r := range scores {
+		words[idx] = r.word
+	}
+	return words
+}
+
+type matchGroup struct {
+	match   Match
+	answers []Word
+}
+
+// partition groups answers by the Match they produce for the guess,
+// preserving their order within each group.
+func partition(guess Word, answers []Word) []matchGroup {
+	var index = make(map[Match]int)
+	var groups []matchGroup
+	for _, a := range answers {
+		m := guess.Match(a)
+		idx, ok := index[m]
+		if !ok {
+			idx = len(groups)
+			index[m] = idx
+			groups = append(groups, matchGroup{match: m})
+		}
+		groups[idx].answers = append(groups[idx].answers, a)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].match.Code() < groups[j].match.Code()
+	})
+	return groups
+}
+
+// solverKey identifies a set of answers and the guesses remaining.
+func solverKey(answers []Word, depth int) string {
+	var b = make([]byte, 0, len(answers)*WordLen+1)
+	b = append(b, byte(depth))
+	for _, a := range answers {
+		b = append(b, a[:]...)
+	}
+	return string(b)
+}
+
+// TreeStrategy plays the guesses from a DecisionTree, using a
+// fallback strategy once the game leaves the tree.
+type TreeStrategy struct {
+	tree     *DecisionTree
+	fallback Strategy
+}
+
+func NewTreeStrategy(tree *DecisionTree, fallback Strategy) TreeStrategy {
+	return TreeStrategy{tree, fallback}
+}
+
+func (t TreeStrategy) Guess(game *Game) Word {
+	var node = t.tree
+	for _, g := range game.Guesses {
+		if node == nil || node.Guess != g.Word {
+			return t.fallback.Guess(game)
+		}
+		node = node.Next[g.Match]
+	}
+	if node == nil || game.CheckGuess(node.Guess) != nil {
+		return t.fallback.Guess(game)
+	}
+	return node.Guess
+}
+
+// OptimalStrategy plays optimal DecisionTrees from a Solver once few
+// enough answers remain, and a fallback strategy until then.
+type OptimalStrategy struct {
+	solver *Solver
+	// Solver for games in hard mode, if any
+	hardSolver *Solver
+	// Fallback strategy when there are too many choices
+	fallback Strategy
+	// Use the fallback strategy when this many words remain
+	threshold int
+}
+
+func NewOptimalStrategy(solver *Solver, fallback Strategy, threshold int) *OptimalStrategy {
+	return &OptimalStrategy{solver, nil, fallback, threshold}
+}
+
+// SetHardSolver gives the strategy a Solver in hard mode, to play games
+// in hard mode. Without one, those games use the usual Solver, and the
+// fallback strategy whenever its guess isn't allowed.
+func (o *OptimalStrategy) SetHardSolver(solver *Solver) {
+	o.hardSolver = solver
+}
+
+func (o *OptimalStrategy) Guess(game *Game) Word {
+	return o.GuessContext(context.Background(), game)
+}
+
+// GuessContext is Guess, but when ctx is done it plays the best tree
+// found so far, or the fallback strategy's guess if there is none.
+func (o *OptimalStrategy) GuessContext(ctx context.Context, game *Game) Word {
+	var possible = game.PossibleAnswers()
+	if len(possible) > o.threshold || len(possible) == 0 {
+		return GuessContext(ctx, o.fallback, game)
+	}
+	var solver = o.solver
+	if game.HardMode() && o.hardSolver != nil {
+		solver = o.hardSolver
+	}
+	var tree = solver.SolveContext(ctx, possible, game.Limit()-len(game.Guesses))
+	if tree == nil || game.CheckGuess(tree.Guess) != nil {
+		return GuessContext(ctx, o.fallback, game)
+	}
+	return tree.Guess
+}
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "optimal",
+		Description: "Play the guess of an exhaustive decision tree search",
+		Params: []Param{
+			{Name: "fallback", Kind: StrategyParam, Description: "Strategy used when too many answers remain", Default: "weighted"},
+			{Name: "threshold", Kind: IntParam, Description: "Possible answers above which the fallback is used", Default: 150},
+			{Name: "objective", Kind: StringParam, Description: "What to minimize. One of: total, worst", Default: "total"},
+			{Name: "candidates", Kind: IntParam, Description: "Words considered for each guess, or 0 for all. Fewer are faster but not optimal", Default: 0},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			var objective Objective
+			switch strings.ToLower(args.String("objective")) {
+			case "total":
+				objective = TotalGuesses
+			case "worst":
+				objective = WorstCase
+			default:
+				return nil, fmt.Errorf("Unrecognized objective: %s", args.String("objective"))
+			}
+			// The solvers' memoized trees are shared by every game.
+			type solverParams struct {
+				objective  Objective
+				candidates int
+				hard       bool
+			}
+			var solvers [2]*Solver
+			for idx, hard := range []bool{false, true} {
+				solver, err := env.Shared(solverParams{objective, args.Int("candidates"), hard}, func() (interface{}, error) {
+					solver := NewSolver(env.Words, objective, args.Int("candidates"))
+					solver.SetHardMode(hard)
+					return solver, nil
+				})
+				if err != nil {
+					return nil, err
+				}
+				solvers[idx] = solver.(*Solver)
+			}
+			optimal := NewOptimalStrategy(solvers[0], args.Strategy("fallback"), args.Int("threshold"))
+			optimal.SetHardSolver(solvers[1])
+			return optimal, nil
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package wordle
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ightAnswers() []Word {
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("light"), mkm(".GGGG"))
+	return game.PossibleAnswers()
+}
+
+func TestSolveTrivial(t *testing.T) {
+	s := NewSolver(globalWords, TotalGuesses, 0)
+	tree := s.Solve([]Word{mkw("cigar")}, GuessLimit)
+	assert.Equal(t, mkw("cigar"), tree.Guess)
+	assert.Equal(t, 1, tree.Cost)
+
+	tree = s.Solve([]Word{mkw("cigar"), mkw("rebut")}, GuessLimit)
+	assert.Equal(t, 3, tree.Cost)
+	assert.Equal(t, 2, tree.Depth())
+
+	assert.Nil(t, s.Solve([]Word{mkw("cigar"), mkw("rebut")}, 1))
+}
+
+func TestSolveIght(t *testing.T) {
+	answers := ightAnswers()
+	for _, objective := range []Objective{TotalGuesses, WorstCase} {
+		s := NewSolver(globalWords, objective, 10)
+		tree := s.Solve(answers, GuessLimit)
+		assert.NotNil(t, tree)
+		assert.Equal(t, len(answers), tree.Answers)
+
+		// Walking the tree finds every answer at the promised cost.
+		strategy := NewTreeStrategy(tree, NaiveStrategy(mkRand(1)))
+		var total, worst int
+		for _, answer := range answers {
+			game := NewGame(answers, nil)
+			for !game.Over() {
+				guess := strategy.Guess(&game)
+				game = game.Guess(guess, guess.Match(answer))
+			}
+			assert.True(t, game.Won(), "%s", answer)
+			total += len(game.Guesses)
+			if len(game.Guesses) > worst {
+				worst = len(game.Guesses)
+			}
+		}
+		assert.Equal(t, worst, tree.Depth())
+		switch objective {
+		case TotalGuesses:
+			assert.Equal(t, total, tree.Cost)
+		case WorstCase:
+			assert.Equal(t, worst, tree.Cost)
+		}
+	}
+}
+
+// An exhaustive search is at least as good as a limited one.
+func TestSolveExhaustive(t *testing.T) {
+	answers := []Word{mkw("areas"), mkw("arias"), mkw("arnas"), mkw("arpas"), mkw("arras")}
+	limited := NewSolver(globalWords, TotalGuesses, 1).Solve(answers, GuessLimit)
+	exact := NewSolver(globalWords, TotalGuesses, 0).Solve(answers, GuessLimit)
+	assert.LessOrEqual(t, exact.Cost, limited.Cost)
+	// Guessing any of the answers can't tell the other four apart, so
+	// the best is a probe like "ferny" followed by one more guess each.
+	assert.Equal(t, 10, exact.Cost)
+	assert.Equal(t, 2, exact.Depth())
+}
+
+// In hard mode the optimal strategy plays the hard mode solver's tree,
+// rather than a probe it isn't allowed to play.
+func TestOptimalHardSolver(t *testing.T) {
+	game := NewGameWithAnswers(globalWords, globalAnswers, nil)
+	game = game.Guess(mkw("crane"), mkm("G...."))
+	solver := NewSolver(globalWords, TotalGuesses, 5)
+	hardSolver := NewSolver(globalWords, TotalGuesses, 5)
+	hardSolver.SetHardMode(true)
+	probe := solver.Solve(game.PossibleAnswers(), GuessLimit-1).Guess
+	assert.NotContains(t, game.PossibleAnswers(), probe)
+
+	game.SetHardMode(true)
+	assert.NotNil(t, game.CheckGuess(probe))
+	optimal := NewOptimalStrategy(solver, NaiveStrategy(mkRand(1)), 150)
+	optimal.SetHardSolver(hardSolver)
+	guess := optimal.Guess(&game)
+	assert.Equal(t, hardSolver.Solve(game.PossibleAnswers(), GuessLimit-1).Guess, guess)
+	assert.Nil(t, game.CheckGuess(guess))
+
+	game.SetHardMode(false)
+	assert.Equal(t, probe, optimal.Guess(&game))
+}
+
+// A search that is cut short returns what it found so far without
+// spoiling the Solver's memo for later searches.
+func TestSolveContext(t *testing.T) {
+	answers := ightAnswers()
+	s := NewSolver(globalWords, TotalGuesses, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Nil(t, s.SolveContext(ctx, answers, GuessLimit))
+	want := NewSolver(globalWords, TotalGuesses, 10).Solve(answers, GuessLimit)
+	assert.Equal(t, want, s.Solve(answers, GuessLimit))
+
+	// Without a tree the optimal strategy plays its fallback.
+	game := NewGame(globalWords, nil)
+	game = game.Guess(mkw("light"), mkm(".GGGG"))
+	optimal := NewOptimalStrategy(NewSolver(globalWords, TotalGuesses, 10), constStrategy(mkw("zzzzz")), 150)
+	assert.Equal(t, mkw("zzzzz"), optimal.GuessContext(ctx, &game))
+	assert.Equal(t, want.Guess, optimal.Guess(&game))
+}
+
+// Concurrent searches share a Solver and agree with a lone search.
+func TestSolveConcurrent(t *testing.T) {
+	answers := ightAnswers()
+	want := NewSolver(globalWords, WorstCase, 10).Solve(answers, GuessLimit)
+	s := NewSolver(globalWords, WorstCase, 10)
+	var wg sync.WaitGroup
+	var trees = make([]*DecisionTree, 4)
+	for idx := range trees {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			trees[idx] = s.Solve(answers, GuessLimit)
+		}(idx)
+	}
+	wg.Wait()
+	for _, tree := range trees {
+		assert.Equal(t, want.Cost, tree.Cost)
+	}
+}
@@ -0,0 +1,43 @@
+package wordle
+
+// GuessStats describes how well a guess splits the possible answers
+// of a Game.
+type GuessStats struct {
+	Word Word
+	// Average number of possible answers left after the guess,
+	// counting none when the guess is the answer.
+	ExpectedRemaining float64
+	// Most possible answers the guess can leave.
+	WorstCase int
+	// Expected information gained by the guess, in bits.
+	Entropy float64
+	// Chance the guess is the answer, if every possible answer is
+	// equally likely.
+	Probability float64
+}
+
+// Stats computes GuessStats for playing guess next.
+func (game Game) Stats(guess Word) GuessStats {
+	var possible = game.PossibleAnswers()
+	var stats = GuessStats{Word: guess}
+	if len(possible) == 0 {
+		return stats
+	}
+	var codes = make([]uint8, len(possible))
+	game.table.Codes(guess, possible, game.table.Index(possible), codes)
+	var buckets matchBuckets
+	buckets.count(codes)
+	var n = float64(len(possible))
+	var remaining int
+	for code, count := range buckets {
+		if code == wonCode {
+			stats.Probability = float64(count) / n
+			continue
+		}
+		remaining += count * count
+	}
+	stats.ExpectedRemaining = float64(remaining) / n
+	stats.WorstCase = buckets.largest()
+	stats.Entropy = buckets.entropy(len(possible))
+	return stats
+}
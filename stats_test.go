@@ -0,0 +1,25 @@
+package wordle
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuessStats(t *testing.T) {
+	answers := []Word{mkw("areas"), mkw("arias"), mkw("arnas"), mkw("arpas"), mkw("arras")}
+	game := NewGameWithAnswers(globalWords, answers, nil)
+
+	stats := game.Stats(mkw("ferny"))
+	assert.Equal(t, mkw("ferny"), stats.Word)
+	assert.InDelta(t, 7.0/5, stats.ExpectedRemaining, 1e-9)
+	assert.Equal(t, 2, stats.WorstCase)
+	assert.InDelta(t, math.Log2(5)-2.0/5, stats.Entropy, 1e-9)
+	assert.Equal(t, 0.0, stats.Probability)
+
+	stats = game.Stats(mkw("arnas"))
+	assert.InDelta(t, 16.0/5, stats.ExpectedRemaining, 1e-9)
+	assert.Equal(t, 4, stats.WorstCase)
+	assert.Equal(t, 0.2, stats.Probability)
+}
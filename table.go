@@ -0,0 +1,147 @@
+package wordle
+
+import (
+	"sync"
+)
+
+// MatchTable holds the precomputed Match, as a Match.Code, for every
+// pair of guess and answer. Each guess's row is computed the first
+// time it's needed, so a table over large word lists is cheap to
+// build and only pays for the guesses actually considered.
+//
+// A nil *MatchTable is valid and computes every Match directly.
+type MatchTable struct {
+	guesses     map[Word]int
+	answers     []Word
+	answerIndex map[Word]int
+	rows        []matchRow
+}
+
+type matchRow struct {
+	once  sync.Once
+	codes []uint8
+}
+
+func NewMatchTable(guesses, answers []Word) *MatchTable {
+	var t = &MatchTable{
+		guesses:     make(map[Word]int, len(guesses)),
+		answers:     answers,
+		answerIndex: make(map[Word]int, len(answers)),
+	}
+	for _, w := range guesses {
+		if _, ok := t.guesses[w]; !ok {
+			t.guesses[w] = len(t.guesses)
+		}
+	}
+	for idx, w := range answers {
+		if _, ok := t.answerIndex[w]; !ok {
+			t.answerIndex[w] = idx
+		}
+	}
+	t.rows = make([]matchRow, len(t.guesses))
+	return t
+}
+
+// row returns the codes of the given guess against every answer, or
+// nil if the guess isn't in the table.
+func (t *MatchTable) row(guess Word) []uint8 {
+	if t == nil {
+		return nil
+	}
+	idx, ok := t.guesses[guess]
+	if !ok {
+		return nil
+	}
+	var r = &t.rows[idx]
+	r.once.Do(func() {
+		r.codes = make([]uint8, len(t.answers))
+		for jdx, answer := range t.answers {
+			r.codes[jdx] = guess.Match(answer).Code()
+		}
+	})
+	return r.codes
+}
+
+// Code returns the Match.Code of guess against answer.
+func (t *MatchTable) Code(guess, answer Word) uint8 {
+	if row := t.row(guess); row != nil {
+		if idx, ok := t.answerIndex[answer]; ok {
+			return row[idx]
+		}
+	}
+	return guess.Match(answer).Code()
+}
+
+// Match returns the Match of guess against answer.
+func (t *MatchTable) Match(guess, answer Word) Match {
+	if row := t.row(guess); row != nil {
+		if idx, ok := t.answerIndex[answer]; ok {
+			return MatchFromCode(row[idx])
+		}
+	}
+	return guess.Match(answer)
+}
+
+// Index returns the position of each word among the table's answers,
+// or -1 for words that aren't answers. Strategies that look up many
+// codes for the same words can use it with Codes.
+func (t *MatchTable) Index(words []Word) []int {
+	var idxs = make([]int, len(words))
+	if t != nil && identical(words, t.answers) {
+		for i := range idxs {
+			idxs[i] = i
+		}
+		return idxs
+	}
+	for i, w := range words {
+		idxs[i] = -1
+		if t != nil {
+			if idx, ok := t.answerIndex[w]; ok {
+				idxs[i] = idx
+			}
+		}
+	}
+	return idxs
+}
+
+// Codes fills codes with the Match.Code of guess against each of the
+// given answers, using indexes from Index.
+func (t *MatchTable) Codes(guess Word, answers []Word, index []int, codes []uint8) {
+	var row = t.row(guess)
+	for i, answer := range answers {
+		if row != nil && index[i] >= 0 {
+			codes[i] = row[index[i]]
+		} else {
+			codes[i] = guess.Match(answer).Code()
+		}
+	}
+}
+
+// FilterPossible is Guess.FilterPossible, comparing precomputed
+// codes where the table has them.
+func (t *MatchTable) FilterPossible(g Guess, words []Word) (possibleAnswers []Word) {
+	var row = t.row(g.Word)
+	if row == nil {
+		return g.FilterPossible(words)
+	}
+	var code = g.Match.Code()
+	if identical(words, t.answers) {
+		for idx, w := range words {
+			if row[idx] == code {
+				possibleAnswers = append(possibleAnswers, w)
+			}
+		}
+		return
+	}
+	var constraints = g.Constraints()
+	for _, w := range words {
+		if idx, ok := t.answerIndex[w]; ok {
+			if row[idx] == code {
+				possibleAnswers = append(possibleAnswers, w)
+			}
+		} else if constraints.Allows(w) {
+			possibleAnswers = append(possibleAnswers, w)
+		}
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package wordle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchTable(t *testing.T) {
+	answers := globalWords[:500]
+	table := NewMatchTable(globalWords, answers)
+	for _, guess := range []Word{mkw("crane"), mkw("geese"), mkw("llama")} {
+		for _, answer := range globalWords[:600] {
+			assert.Equal(t, guess.Match(answer), table.Match(guess, answer))
+			assert.Equal(t, guess.Match(answer).Code(), table.Code(guess, answer))
+		}
+		g := Guess{guess, guess.Match(mkw("aback"))}
+		assert.Equal(t, g.FilterPossible(globalWords), table.FilterPossible(g, globalWords))
+	}
+
+	var nilTable *MatchTable
+	assert.Equal(t, mkm("..y.."), nilTable.Match(mkw("petar"), mkw("cloth")))
+	assert.Equal(t, []int{-1}, nilTable.Index([]Word{mkw("cloth")}))
+}
+
+func TestFilteringPlayMatchTable(t *testing.T) {
+	play := func(table *MatchTable) []Guess {
+		rng := mkRand(1)
+		fallback := NewWeightedStrategy(rng, NewUniqueLettersScoring(), 1)
+		strategy := NewFilteringStrategy(rng, globalLog, fallback, 60, NewUniqueLettersScoring())
+		game := NewGame(globalWords, nil)
+		game.UseMatchTable(table)
+		answer := mkw("cigar")
+		for !game.Over() {
+			guess := strategy.Guess(&game)
+			game = game.Guess(guess, guess.Match(answer))
+		}
+		return game.Guesses
+	}
+	assert.Equal(t, play(nil), play(NewMatchTable(globalWords, globalWords)))
+}
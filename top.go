@@ -17,6 +17,9 @@ func NewTop(rng *rand.Rand, scoring Scoring) *Top {
 
 func (x *Top) Guess(game *Game) Word {
 	var possible = game.PossibleAnswers()
+	if len(possible) == 0 {
+		possible = game.anyPlayable()
+	}
 	var weights = x.scoring.Weights(possible)
 	var top = weights[0]
 	var topIdx = 0
@@ -42,3 +45,16 @@ func (x *Top) Guess(game *Game) Word {
 	var choice = x.rng.Intn(len(choices))
 	return choices[choice]
 }
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "top",
+		Description: "Guess the possible answer with the best score",
+		Params: []Param{
+			{Name: "scoring", Kind: ScoringParam, Description: "Scoring to rank answers by", Default: "freq"},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NewTop(rng, args.Scoring("scoring")), nil
+		},
+	})
+}
@@ -20,6 +20,9 @@ func NewWeightedStrategy(rng *rand.Rand, scoring Scoring, pow float64) *Weighted
 
 func (x *WeightedStrategy) Guess(game *Game) Word {
 	var possible = game.PossibleAnswers()
+	if len(possible) == 0 {
+		possible = game.anyPlayable()
+	}
 	var weights = x.scoring.Weights(possible)
 	var idx = weightedSample(x.rng, x.pow, weights)
 	return possible[idx]
@@ -37,3 +40,17 @@ func weightedSample(rng *rand.Rand, pow float64, weights []float64) int {
 		return offset[i] >= choice
 	})
 }
+
+func init() {
+	RegisterStrategy(StrategyDef{
+		Name:        "weighted",
+		Description: "Guess a possible answer at random, weighted by a scoring",
+		Params: []Param{
+			{Name: "scoring", Kind: ScoringParam, Description: "Scoring to weight answers by", Default: "freq"},
+			{Name: "exp", Kind: FloatParam, Description: "Exponent applied to the weights", Default: 1.0},
+		},
+		New: func(env *Env, rng *rand.Rand, args Args) (Strategy, error) {
+			return NewWeightedStrategy(rng, args.Scoring("scoring"), args.Float("exp")), nil
+		},
+	})
+}
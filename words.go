@@ -56,6 +56,9 @@ func (guess Word) Match(actual Word) Match {
 		}
 	}
 	for i, g := range guess {
+		if maskSet(m.exact, i) {
+			continue
+		}
 		if lc.Remove(g) {
 			m.SetUsed(i, false)
 		}
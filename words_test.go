@@ -27,4 +27,7 @@ func TestWordMatch(t *testing.T) {
 
 	assert.Equal(t, mkm("..y.."), mkw("fuzzy").Match(mkw("zilch")))
 	assert.Equal(t, mkm("....g"), mkw("eagle").Match(mkw("wince")))
+	assert.Equal(t, mkm(".yy.."), mkw("geese").Match(mkw("embed")))
+	// A green square doesn't use up a yellow for the same letter.
+	assert.Equal(t, mkm("Gy..."), mkw("aabbb").Match(mkw("acade")))
 }